@@ -0,0 +1,136 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/novshi-tech/atl-cli/internal/bitbucket"
+	"github.com/spf13/cobra"
+)
+
+var bbBranchCmd = &cobra.Command{
+	Use:   "branch",
+	Short: "Manage repository branches",
+}
+
+func init() {
+	bitbucketCmd.AddCommand(bbBranchCmd)
+}
+
+// addBBRefListFlags adds the flags shared by branch list and tag list.
+func addBBRefListFlags(c *cobra.Command) {
+	c.Flags().String("workspace", "", "Workspace slug")
+	c.Flags().String("repo", "", "Repository slug (required)")
+	c.MarkFlagRequired("repo")
+	c.Flags().String("filter", "", "Only names containing this text, or matching this glob (e.g. feature/*)")
+	c.Flags().String("sort", "-date", "Sort order: name, -name, date or -date (newest first)")
+	c.Flags().Int("max", 50, "Maximum number of results")
+	c.Flags().Bool("all", false, "Return all results, ignoring --max")
+}
+
+// refSortFields maps --sort values to the API's sort fields.
+var refSortFields = map[string]string{
+	"name":  "name",
+	"-name": "-name",
+	"date":  "target.date",
+	"-date": "-target.date",
+}
+
+// runBBRefList implements branch list and tag list; kind is "branch" or
+// "tag".
+func runBBRefList(cmd *cobra.Command, kind string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+	filter, _ := cmd.Flags().GetString("filter")
+	sortArg, _ := cmd.Flags().GetString("sort")
+	max, _ := cmd.Flags().GetInt("max")
+	all, _ := cmd.Flags().GetBool("all")
+
+	sort, ok := refSortFields[sortArg]
+	if !ok {
+		return fmt.Errorf("invalid --sort %q; must be one of: name, -name, date, -date", sortArg)
+	}
+	if all {
+		max = 0
+	}
+
+	list, plural, counted := client.ListBranches, "branches", "branch(es)"
+	if kind == "tag" {
+		list, plural, counted = client.ListTags, "tags", "tag(s)"
+	}
+	refs, err := list(workspace, repo, filter, sort, max)
+	if err != nil {
+		return err
+	}
+
+	items := make([]JSONRef, len(refs))
+	for i, r := range refs {
+		items[i] = toJSONRef(workspace, repo, kind, r)
+	}
+
+	if jsonMode(cmd) {
+		return printJSON(items)
+	}
+
+	if len(items) == 0 {
+		fmt.Printf("No %s found.\n", plural)
+		return nil
+	}
+
+	fmt.Printf("Found %d %s:\n\n", len(items), counted)
+	for _, r := range items {
+		fmt.Printf("%-40s  %-12s  %-10s  %s\n", r.Name, shortHash(r.Commit), dateOnly(r.Date), r.Author)
+	}
+	return nil
+}
+
+func toJSONRef(workspace, repo, kind string, r bitbucket.Ref) JSONRef {
+	item := JSONRef{
+		Name:    r.Name,
+		Commit:  r.Target.Hash,
+		Date:    r.Target.Date,
+		Author:  commitAuthorName(r.Target.Author),
+		Message: strings.TrimSpace(r.Target.Message),
+		URL:     bbRefURL(workspace, repo, kind, r.Name),
+	}
+	if r.Message != "" {
+		item.Message = strings.TrimSpace(r.Message)
+	}
+	return item
+}
+
+// bbRefURL returns the web URL of a branch or tag.
+func bbRefURL(workspace, repo, kind, name string) string {
+	if kind == "tag" {
+		return fmt.Sprintf("https://bitbucket.org/%s/%s/src/%s", workspace, repo, name)
+	}
+	return fmt.Sprintf("https://bitbucket.org/%s/%s/branch/%s", workspace, repo, name)
+}
+
+// commitAuthorName prefers the Bitbucket account's display name over the
+// raw "Name <email>" of the commit.
+func commitAuthorName(a bitbucket.CommitAuthor) string {
+	if a.User != nil && a.User.DisplayName != "" {
+		return a.User.DisplayName
+	}
+	if i := strings.Index(a.Raw, " <"); i > 0 {
+		return a.Raw[:i]
+	}
+	return a.Raw
+}
+
+// dateOnly trims an API timestamp to its YYYY-MM-DD part.
+func dateOnly(ts string) string {
+	if len(ts) > 10 {
+		return ts[:10]
+	}
+	return ts
+}
@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var bbBranchCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a branch",
+	Long: "Create a branch from --from, a branch name or commit hash. Without " +
+		"--from the branch starts at the head of the repository's main branch.",
+	RunE: runBBBranchCreate,
+}
+
+func init() {
+	bbBranchCreateCmd.Flags().String("workspace", "", "Workspace slug")
+	bbBranchCreateCmd.Flags().String("repo", "", "Repository slug (required)")
+	bbBranchCreateCmd.MarkFlagRequired("repo")
+	bbBranchCreateCmd.Flags().String("name", "", "Branch name (required)")
+	bbBranchCreateCmd.MarkFlagRequired("name")
+	bbBranchCreateCmd.Flags().String("from", "", "Branch or commit to start from (default: main branch)")
+	bbBranchCmd.AddCommand(bbBranchCreateCmd)
+}
+
+func runBBBranchCreate(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+	name, _ := cmd.Flags().GetString("name")
+	from, _ := cmd.Flags().GetString("from")
+
+	if from == "" {
+		r, err := client.GetRepository(workspace, repo)
+		if err != nil {
+			return err
+		}
+		if r.MainBranch == nil {
+			return fmt.Errorf("repository %s has no main branch; pass --from", repo)
+		}
+		from = r.MainBranch.Name
+	}
+
+	ref, err := client.CreateBranch(workspace, repo, name, from)
+	if err != nil {
+		return err
+	}
+
+	url := bbRefURL(workspace, repo, "branch", ref.Name)
+	if jsonMode(cmd) {
+		return printJSON(JSONMutationResult{Key: ref.Name, URL: url})
+	}
+
+	fmt.Printf("Created branch %s at %s\n", ref.Name, shortHash(ref.Target.Hash))
+	fmt.Printf("URL: %s\n", url)
+	return nil
+}
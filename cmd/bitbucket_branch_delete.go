@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var bbBranchDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete a branch",
+	RunE:  runBBBranchDelete,
+}
+
+func init() {
+	bbBranchDeleteCmd.Flags().String("workspace", "", "Workspace slug")
+	bbBranchDeleteCmd.Flags().String("repo", "", "Repository slug (required)")
+	bbBranchDeleteCmd.MarkFlagRequired("repo")
+	bbBranchDeleteCmd.Flags().String("name", "", "Branch name (required)")
+	bbBranchDeleteCmd.MarkFlagRequired("name")
+	bbBranchDeleteCmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+	bbBranchCmd.AddCommand(bbBranchDeleteCmd)
+}
+
+func runBBBranchDelete(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+	name, _ := cmd.Flags().GetString("name")
+
+	if err := confirmDestructive(cmd, fmt.Sprintf("Delete branch %s in %s?", name, repo)); err != nil {
+		return err
+	}
+	if err := client.DeleteBranch(workspace, repo, name); err != nil {
+		return err
+	}
+
+	if jsonMode(cmd) {
+		return printJSON(JSONMutationResult{Key: name})
+	}
+
+	fmt.Printf("Deleted branch %s\n", name)
+	return nil
+}
@@ -0,0 +1,18 @@
+package cmd
+
+import "github.com/spf13/cobra"
+
+var bbBranchListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List branches in a repository",
+	Long: "List branches in a repository. --filter matches names containing the given text, or a " +
+		"glob such as release/* that must match the whole name.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runBBRefList(cmd, "branch")
+	},
+}
+
+func init() {
+	addBBRefListFlags(bbBranchListCmd)
+	bbBranchCmd.AddCommand(bbBranchListCmd)
+}
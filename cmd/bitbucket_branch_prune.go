@@ -0,0 +1,216 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/novshi-tech/atl-cli/internal/bitbucket"
+	"github.com/spf13/cobra"
+)
+
+var bbBranchPruneCmd = &cobra.Command{
+	Use:   "prune",
+	Short: "Delete branches whose pull requests have been merged",
+	Long: "Delete stale branches left behind by merged pull requests. A branch is " +
+		"pruned only if a pull request from it into --merged-into (default: the " +
+		"main branch) was merged, its head is still the commit that was merged, " +
+		"it is not the source of an open pull request, and its last commit is " +
+		"older than --older-than (e.g. 90d, 12w or 72h). Use --dry-run to list " +
+		"the branches without deleting them.",
+	RunE: runBBBranchPrune,
+}
+
+func init() {
+	bbBranchPruneCmd.Flags().String("workspace", "", "Workspace slug")
+	bbBranchPruneCmd.Flags().String("repo", "", "Repository slug (required)")
+	bbBranchPruneCmd.MarkFlagRequired("repo")
+	bbBranchPruneCmd.Flags().String("merged-into", "", "Destination branch the pull requests were merged into (default: main branch)")
+	bbBranchPruneCmd.Flags().String("older-than", "", "Only prune branches whose last commit is older than this (e.g. 90d, 12w, 72h)")
+	bbBranchPruneCmd.Flags().String("filter", "", "Only consider branch names containing this text, or matching this glob")
+	bbBranchPruneCmd.Flags().Bool("dry-run", false, "List the branches that would be deleted without deleting them")
+	bbBranchPruneCmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+	bbBranchCmd.AddCommand(bbBranchPruneCmd)
+}
+
+func runBBBranchPrune(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+	mergedInto, _ := cmd.Flags().GetString("merged-into")
+	olderThan, _ := cmd.Flags().GetString("older-than")
+	filter, _ := cmd.Flags().GetString("filter")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+	var cutoff time.Time
+	if olderThan != "" {
+		age, err := parseAge(olderThan)
+		if err != nil {
+			return err
+		}
+		cutoff = time.Now().Add(-age)
+	}
+
+	r, err := client.GetRepository(workspace, repo)
+	if err != nil {
+		return err
+	}
+	mainBranch := ""
+	if r.MainBranch != nil {
+		mainBranch = r.MainBranch.Name
+	}
+	if mergedInto == "" {
+		if mainBranch == "" {
+			return fmt.Errorf("repository %s has no main branch; pass --merged-into", repo)
+		}
+		mergedInto = mainBranch
+	}
+
+	merged, err := client.ListPullRequests(workspace, repo, bitbucket.PRFilter{States: []string{"MERGED"}, Dest: mergedInto}, 0)
+	if err != nil {
+		return err
+	}
+	open, err := client.ListPullRequests(workspace, repo, bitbucket.PRFilter{States: []string{"OPEN"}}, 0)
+	if err != nil {
+		return err
+	}
+	hasOpenPR := make(map[string]bool)
+	for _, pr := range open {
+		hasOpenPR[pr.Source.Branch.Name] = true
+	}
+
+	// Merged pull requests by source branch, skipping those from forks,
+	// whose branches don't live in this repository.
+	fullName := workspace + "/" + repo
+	mergedFrom := make(map[string][]bitbucket.PullRequest)
+	for _, pr := range merged {
+		if pr.Source.Repository != nil && !strings.EqualFold(pr.Source.Repository.FullName, fullName) {
+			continue
+		}
+		mergedFrom[pr.Source.Branch.Name] = append(mergedFrom[pr.Source.Branch.Name], pr)
+	}
+
+	branches, err := client.ListBranches(workspace, repo, filter, "name", 0)
+	if err != nil {
+		return err
+	}
+
+	candidates := []JSONPrunedBranch{}
+	for _, b := range branches {
+		if b.Name == mergedInto || b.Name == mainBranch || hasOpenPR[b.Name] {
+			continue
+		}
+		prID := mergedHeadPR(mergedFrom[b.Name], b.Target.Hash)
+		if prID == 0 {
+			continue
+		}
+		if !cutoff.IsZero() {
+			date, err := time.Parse(time.RFC3339Nano, b.Target.Date)
+			if err != nil || !date.Before(cutoff) {
+				continue
+			}
+		}
+		candidates = append(candidates, JSONPrunedBranch{
+			Name:        b.Name,
+			Commit:      b.Target.Hash,
+			Date:        b.Target.Date,
+			PullRequest: prID,
+		})
+	}
+
+	if dryRun || len(candidates) == 0 {
+		if jsonMode(cmd) {
+			return printJSON(candidates)
+		}
+		if len(candidates) == 0 {
+			fmt.Println("No branches to prune.")
+			return nil
+		}
+		fmt.Printf("Would delete %d branch(es):\n\n", len(candidates))
+		printPrunedBranches(candidates)
+		return nil
+	}
+
+	if !jsonMode(cmd) {
+		fmt.Printf("Found %d branch(es) to delete:\n\n", len(candidates))
+		printPrunedBranches(candidates)
+		fmt.Println()
+	}
+	if err := confirmDestructive(cmd, fmt.Sprintf("Delete %d branch(es) in %s?", len(candidates), repo)); err != nil {
+		return err
+	}
+
+	failed := 0
+	for i := range candidates {
+		c := &candidates[i]
+		if err := client.DeleteBranch(workspace, repo, c.Name); err != nil {
+			c.Error = err.Error()
+			failed++
+			fmt.Fprintf(os.Stderr, "Failed to delete branch %s: %v\n", c.Name, err)
+			continue
+		}
+		c.Deleted = true
+		if !jsonMode(cmd) {
+			fmt.Printf("Deleted branch %s\n", c.Name)
+		}
+	}
+
+	if jsonMode(cmd) {
+		if err := printJSON(candidates); err != nil {
+			return err
+		}
+	}
+	if failed > 0 {
+		cmd.SilenceUsage = true
+		return fmt.Errorf("failed to delete %d of %d branch(es)", failed, len(candidates))
+	}
+	return nil
+}
+
+// mergedHeadPR returns the ID of the pull request in prs whose merged
+// source commit is head, or 0 if the branch has moved on since (or was
+// never merged). Pull request responses carry abbreviated hashes.
+func mergedHeadPR(prs []bitbucket.PullRequest, head string) int {
+	for _, pr := range prs {
+		if pr.Source.Commit != nil && pr.Source.Commit.Hash != "" && strings.HasPrefix(head, pr.Source.Commit.Hash) {
+			return pr.ID
+		}
+	}
+	return 0
+}
+
+func printPrunedBranches(branches []JSONPrunedBranch) {
+	for _, b := range branches {
+		fmt.Printf("%-40s  %-12s  %-10s  PR #%d\n", b.Name, shortHash(b.Commit), dateOnly(b.Date), b.PullRequest)
+	}
+}
+
+// parseAge parses an age such as "90d" or "12w", or any time.ParseDuration
+// string such as "72h".
+func parseAge(s string) (time.Duration, error) {
+	unit := time.Duration(0)
+	switch {
+	case strings.HasSuffix(s, "d"):
+		unit = 24 * time.Hour
+	case strings.HasSuffix(s, "w"):
+		unit = 7 * 24 * time.Hour
+	}
+	if unit != 0 {
+		n, err := strconv.Atoi(s[:len(s)-1])
+		if err == nil && n >= 0 {
+			return time.Duration(n) * unit, nil
+		}
+	} else if d, err := time.ParseDuration(s); err == nil && d >= 0 {
+		return d, nil
+	}
+	return 0, fmt.Errorf("invalid age %q; use e.g. 90d, 12w or 72h", s)
+}
@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/novshi-tech/atl-cli/internal/bitbucket"
+	"github.com/spf13/cobra"
+)
+
+var bbBranchRestrictionCmd = &cobra.Command{
+	Use:   "branch-restriction",
+	Short: "Manage branch permissions and merge checks",
+	Long: "Manage branch restrictions: who may push, merge, delete or force-push to " +
+		"matching branches, and the merge checks (required approvals, passing " +
+		"builds, ...) pull requests into them must satisfy.",
+}
+
+func init() {
+	bitbucketCmd.AddCommand(bbBranchRestrictionCmd)
+}
+
+func toJSONBranchRestriction(r bitbucket.BranchRestriction) JSONBranchRestriction {
+	item := JSONBranchRestriction{
+		ID:     r.ID,
+		Kind:   r.Kind,
+		Value:  r.Value,
+		Users:  []string{},
+		Groups: []string{},
+	}
+	if r.BranchMatchKind == "branching_model" {
+		item.BranchType = r.BranchType
+	} else {
+		item.Pattern = r.Pattern
+	}
+	for _, u := range r.Users {
+		item.Users = append(item.Users, u.DisplayName)
+	}
+	for _, g := range r.Groups {
+		item.Groups = append(item.Groups, g.Slug)
+	}
+	return item
+}
+
+// restrictionDetails summarises the value and exemptions of a restriction,
+// e.g. "value=2" or "users: Jane Doe; groups: admins".
+func restrictionDetails(r JSONBranchRestriction) string {
+	var parts []string
+	if r.Value != nil {
+		parts = append(parts, fmt.Sprintf("value=%d", *r.Value))
+	}
+	if len(r.Users) > 0 {
+		parts = append(parts, "users: "+strings.Join(r.Users, ", "))
+	}
+	if len(r.Groups) > 0 {
+		parts = append(parts, "groups: "+strings.Join(r.Groups, ", "))
+	}
+	return strings.Join(parts, "; ")
+}
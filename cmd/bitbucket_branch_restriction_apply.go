@@ -0,0 +1,192 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/novshi-tech/atl-cli/internal/bitbucket"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+var bbBranchRestrictionApplyCmd = &cobra.Command{
+	Use:   "apply",
+	Short: "Sync branch restrictions from a YAML policy file",
+	Long: "Make the branch restrictions of one or more repositories match a YAML " +
+		"policy file: missing restrictions are created, ones with a different " +
+		"value, users or groups are updated, and restrictions not in the file " +
+		"are deleted. Restrictions are matched by kind and pattern (or branch " +
+		"type). The file looks like:\n\n" +
+		"  restrictions:\n" +
+		"    - kind: push\n" +
+		"      pattern: main\n" +
+		"      users: [jdoe]\n" +
+		"      groups: [release-managers]\n" +
+		"    - kind: require_approvals_to_merge\n" +
+		"      pattern: main\n" +
+		"      value: 2\n" +
+		"    - kind: delete\n" +
+		"      branch_type: release\n\n" +
+		"Use --dry-run to print the plan without changing anything.",
+	RunE: runBBBranchRestrictionApply,
+}
+
+func init() {
+	bbBranchRestrictionApplyCmd.Flags().String("workspace", "", "Workspace slug")
+	bbBranchRestrictionApplyCmd.Flags().StringSlice("repo", nil, "Repository slug(s) to apply the policy to (required, repeatable)")
+	bbBranchRestrictionApplyCmd.MarkFlagRequired("repo")
+	bbBranchRestrictionApplyCmd.Flags().StringP("file", "f", "", "Policy file ('-' for stdin) (required)")
+	bbBranchRestrictionApplyCmd.MarkFlagRequired("file")
+	bbBranchRestrictionApplyCmd.Flags().Bool("dry-run", false, "Print the changes without applying them")
+	bbBranchRestrictionApplyCmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt for deletions")
+	bbBranchRestrictionCmd.AddCommand(bbBranchRestrictionApplyCmd)
+}
+
+// restrictionPolicy is the YAML file read by branch-restriction apply.
+type restrictionPolicy struct {
+	Restrictions []restrictionRule `yaml:"restrictions"`
+}
+
+type restrictionRule struct {
+	Kind       string   `yaml:"kind"`
+	Pattern    string   `yaml:"pattern"`
+	BranchType string   `yaml:"branch_type"`
+	Value      *int     `yaml:"value"`
+	Users      []string `yaml:"users"`
+	Groups     []string `yaml:"groups"`
+}
+
+func runBBBranchRestrictionApply(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repos, _ := cmd.Flags().GetStringSlice("repo")
+	file, _ := cmd.Flags().GetString("file")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+	policy, err := readRestrictionPolicy(file)
+	if err != nil {
+		return err
+	}
+
+	userCache := make(map[string]string)
+	desired := make([]bitbucket.BranchRestrictionRequest, len(policy.Restrictions))
+	for i, rule := range policy.Restrictions {
+		users, err := resolveBBUserUUIDs(client, workspace, rule.Users, userCache)
+		if err != nil {
+			return fmt.Errorf("%s: restriction %d: %w", file, i+1, err)
+		}
+		desired[i], err = bitbucket.NewBranchRestrictionRequest(rule.Kind, rule.Pattern, rule.BranchType, rule.Value, users, rule.Groups)
+		if err != nil {
+			return fmt.Errorf("%s: restriction %d: %w", file, i+1, err)
+		}
+	}
+
+	type repoPlan struct {
+		repo    string
+		changes []bitbucket.BranchRestrictionChange
+	}
+	var plans []repoPlan
+	deletions := 0
+	for _, repo := range repos {
+		existing, err := client.ListBranchRestrictions(workspace, repo)
+		if err != nil {
+			return fmt.Errorf("%s: %w", repo, err)
+		}
+		changes := bitbucket.PlanBranchRestrictions(existing, desired)
+		for _, c := range changes {
+			if c.Action == "delete" {
+				deletions++
+			}
+		}
+		plans = append(plans, repoPlan{repo, changes})
+	}
+
+	results := []JSONRestrictionChange{}
+	for _, p := range plans {
+		for _, c := range p.changes {
+			item := JSONRestrictionChange{Repo: p.repo, Action: c.Action, Kind: c.Request.Kind, Match: c.Request.Match()}
+			if c.Current != nil {
+				item.ID, item.Kind, item.Match = c.Current.ID, c.Current.Kind, c.Current.Match()
+			}
+			results = append(results, item)
+		}
+	}
+
+	if !jsonMode(cmd) {
+		if len(results) == 0 {
+			fmt.Println("Branch restrictions are up to date.")
+			return nil
+		}
+		for _, r := range results {
+			fmt.Println(formatRestrictionChange(r))
+		}
+	}
+	if dryRun || len(results) == 0 {
+		if jsonMode(cmd) {
+			return printJSON(results)
+		}
+		return nil
+	}
+
+	if deletions > 0 {
+		if err := confirmDestructive(cmd, fmt.Sprintf("Delete %d branch restriction(s)?", deletions)); err != nil {
+			return err
+		}
+	}
+
+	i := 0
+	for _, p := range plans {
+		for _, c := range p.changes {
+			var err error
+			switch c.Action {
+			case "create":
+				_, err = client.CreateBranchRestriction(workspace, p.repo, c.Request)
+			case "update":
+				_, err = client.UpdateBranchRestriction(workspace, p.repo, c.Current.ID, c.Request)
+			case "delete":
+				err = client.DeleteBranchRestriction(workspace, p.repo, c.Current.ID)
+			}
+			if err != nil {
+				return fmt.Errorf("%s: %s %s on %s: %w", p.repo, c.Action, results[i].Kind, results[i].Match, err)
+			}
+			results[i].Applied = true
+			i++
+		}
+	}
+
+	if jsonMode(cmd) {
+		return printJSON(results)
+	}
+	fmt.Printf("\nApplied %d change(s) to %d repositor(ies).\n", len(results), len(repos))
+	return nil
+}
+
+func readRestrictionPolicy(file string) (*restrictionPolicy, error) {
+	data, err := readBodyFile(file)
+	if err != nil {
+		return nil, err
+	}
+	dec := yaml.NewDecoder(strings.NewReader(data))
+	dec.KnownFields(true)
+	var policy restrictionPolicy
+	if err := dec.Decode(&policy); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", file, err)
+	}
+	return &policy, nil
+}
+
+func formatRestrictionChange(c JSONRestrictionChange) string {
+	sign := map[string]string{"create": "+", "update": "~", "delete": "-"}[c.Action]
+	id := ""
+	if c.ID != 0 {
+		id = fmt.Sprintf(" (#%d)", c.ID)
+	}
+	return fmt.Sprintf("%s %s: %s %s on %s%s", sign, c.Repo, c.Action, c.Kind, c.Match, id)
+}
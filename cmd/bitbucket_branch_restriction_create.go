@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/novshi-tech/atl-cli/internal/bitbucket"
+	"github.com/spf13/cobra"
+)
+
+var bbBranchRestrictionCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a branch restriction",
+	Long: "Create a branch restriction of --kind on the branches matching --pattern " +
+		"(a glob) or of --branch-type. push and restrict_merges restrict the " +
+		"action to the --user and --group given; require_approvals_to_merge, " +
+		"require_default_reviewer_approvals_to_merge, " +
+		"require_passing_builds_to_merge and require_commits_behind take a --value.",
+	RunE: runBBBranchRestrictionCreate,
+}
+
+func init() {
+	bbBranchRestrictionCreateCmd.Flags().String("workspace", "", "Workspace slug")
+	bbBranchRestrictionCreateCmd.Flags().String("repo", "", "Repository slug (required)")
+	bbBranchRestrictionCreateCmd.MarkFlagRequired("repo")
+	bbBranchRestrictionCreateCmd.Flags().String("kind", "", "Restriction kind, e.g. push, force, delete, require_approvals_to_merge (required)")
+	bbBranchRestrictionCreateCmd.MarkFlagRequired("kind")
+	bbBranchRestrictionCreateCmd.Flags().String("pattern", "", "Branch name or glob, e.g. main or release/*")
+	bbBranchRestrictionCreateCmd.Flags().String("branch-type", "", "Branching model type: feature, bugfix, release, hotfix, development or production")
+	bbBranchRestrictionCreateCmd.MarkFlagsMutuallyExclusive("pattern", "branch-type")
+	bbBranchRestrictionCreateCmd.MarkFlagsOneRequired("pattern", "branch-type")
+	bbBranchRestrictionCreateCmd.Flags().Int("value", 0, "Required count for kinds that take one (e.g. number of approvals)")
+	bbBranchRestrictionCreateCmd.Flags().StringSlice("user", nil, "User allowed despite the restriction (repeatable; push and restrict_merges only)")
+	bbBranchRestrictionCreateCmd.Flags().StringSlice("group", nil, "Group slug allowed despite the restriction (repeatable; push and restrict_merges only)")
+	bbBranchRestrictionCmd.AddCommand(bbBranchRestrictionCreateCmd)
+}
+
+func runBBBranchRestrictionCreate(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+	kind, _ := cmd.Flags().GetString("kind")
+	pattern, _ := cmd.Flags().GetString("pattern")
+	branchType, _ := cmd.Flags().GetString("branch-type")
+	userRefs, _ := cmd.Flags().GetStringSlice("user")
+	groups, _ := cmd.Flags().GetStringSlice("group")
+
+	var value *int
+	if cmd.Flags().Changed("value") {
+		v, _ := cmd.Flags().GetInt("value")
+		value = &v
+	}
+	users, err := resolveBBUserUUIDs(client, workspace, userRefs, map[string]string{})
+	if err != nil {
+		return err
+	}
+	req, err := bitbucket.NewBranchRestrictionRequest(kind, pattern, branchType, value, users, groups)
+	if err != nil {
+		return err
+	}
+
+	r, err := client.CreateBranchRestriction(workspace, repo, req)
+	if err != nil {
+		return err
+	}
+
+	if jsonMode(cmd) {
+		return printJSON(toJSONBranchRestriction(*r))
+	}
+
+	fmt.Printf("Created branch restriction #%d: %s on %s\n", r.ID, r.Kind, r.Match())
+	return nil
+}
@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+)
+
+var bbBranchRestrictionDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete a branch restriction",
+	RunE:  runBBBranchRestrictionDelete,
+}
+
+func init() {
+	bbBranchRestrictionDeleteCmd.Flags().String("workspace", "", "Workspace slug")
+	bbBranchRestrictionDeleteCmd.Flags().String("repo", "", "Repository slug (required)")
+	bbBranchRestrictionDeleteCmd.MarkFlagRequired("repo")
+	bbBranchRestrictionDeleteCmd.Flags().Int("id", 0, "Branch restriction ID (required)")
+	bbBranchRestrictionDeleteCmd.MarkFlagRequired("id")
+	bbBranchRestrictionDeleteCmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+	bbBranchRestrictionCmd.AddCommand(bbBranchRestrictionDeleteCmd)
+}
+
+func runBBBranchRestrictionDelete(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+	id, _ := cmd.Flags().GetInt("id")
+
+	if err := confirmDestructive(cmd, fmt.Sprintf("Delete branch restriction #%d in %s?", id, repo)); err != nil {
+		return err
+	}
+	if err := client.DeleteBranchRestriction(workspace, repo, id); err != nil {
+		return err
+	}
+
+	if jsonMode(cmd) {
+		return printJSON(JSONMutationResult{Key: strconv.Itoa(id)})
+	}
+
+	fmt.Printf("Deleted branch restriction #%d\n", id)
+	return nil
+}
@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var bbBranchRestrictionListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List branch restrictions",
+	RunE:  runBBBranchRestrictionList,
+}
+
+func init() {
+	bbBranchRestrictionListCmd.Flags().String("workspace", "", "Workspace slug")
+	bbBranchRestrictionListCmd.Flags().String("repo", "", "Repository slug (required)")
+	bbBranchRestrictionListCmd.MarkFlagRequired("repo")
+	bbBranchRestrictionCmd.AddCommand(bbBranchRestrictionListCmd)
+}
+
+func runBBBranchRestrictionList(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+
+	restrictions, err := client.ListBranchRestrictions(workspace, repo)
+	if err != nil {
+		return err
+	}
+
+	items := make([]JSONBranchRestriction, len(restrictions))
+	for i, r := range restrictions {
+		items[i] = toJSONBranchRestriction(r)
+	}
+
+	if jsonMode(cmd) {
+		return printJSON(items)
+	}
+
+	if len(items) == 0 {
+		fmt.Println("No branch restrictions found.")
+		return nil
+	}
+
+	fmt.Printf("Found %d branch restriction(s):\n\n", len(items))
+	for i, r := range items {
+		fmt.Printf("#%-8d  %-45s  %-20s  %s\n", r.ID, r.Kind, restrictions[i].Match(), restrictionDetails(r))
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/novshi-tech/atl-cli/internal/bitbucket"
+	"github.com/spf13/cobra"
+)
+
+var bbCommitCmd = &cobra.Command{
+	Use:   "commit",
+	Short: "Browse commits and commit comments",
+}
+
+func init() {
+	bitbucketCmd.AddCommand(bbCommitCmd)
+}
+
+// bbCommitURL returns the web URL of a commit.
+func bbCommitURL(workspace, repo, hash string) string {
+	return fmt.Sprintf("https://bitbucket.org/%s/%s/commits/%s", workspace, repo, hash)
+}
+
+func toJSONCommitItem(workspace, repo string, c bitbucket.Commit) JSONCommitItem {
+	return JSONCommitItem{
+		Hash:    c.Hash,
+		Author:  commitAuthorName(c.Author),
+		Date:    c.Date,
+		Message: strings.TrimSpace(c.Message),
+		URL:     bbCommitURL(workspace, repo, c.Hash),
+	}
+}
+
+// printCommitList prints commits one per line, shortened to the subject
+// line of their message.
+func printCommitList(cmd *cobra.Command, workspace, repo string, commits []bitbucket.Commit) error {
+	items := make([]JSONCommitItem, len(commits))
+	for i, c := range commits {
+		items[i] = toJSONCommitItem(workspace, repo, c)
+	}
+
+	if jsonMode(cmd) {
+		return printJSON(items)
+	}
+
+	if len(items) == 0 {
+		fmt.Println("No commits found.")
+		return nil
+	}
+
+	fmt.Printf("Found %d commit(s):\n\n", len(items))
+	for _, c := range items {
+		subject, _, _ := strings.Cut(c.Message, "\n")
+		fmt.Printf("%-12s  %-10s  %-20s  %s\n", shortHash(c.Hash), dateOnly(c.Date), c.Author, subject)
+	}
+	return nil
+}
@@ -0,0 +1,12 @@
+package cmd
+
+import "github.com/spf13/cobra"
+
+var bbCommitCommentCmd = &cobra.Command{
+	Use:   "comment",
+	Short: "Manage comments on a commit",
+}
+
+func init() {
+	bbCommitCmd.AddCommand(bbCommitCommentCmd)
+}
@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/novshi-tech/atl-cli/internal/bitbucket"
+	"github.com/spf13/cobra"
+)
+
+var bbCommitCommentCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a comment on a commit",
+	RunE:  runBBCommitCommentCreate,
+}
+
+func init() {
+	bbCommitCommentCreateCmd.Flags().String("workspace", "", "Workspace slug")
+	bbCommitCommentCreateCmd.Flags().String("repo", "", "Repository slug (required)")
+	bbCommitCommentCreateCmd.MarkFlagRequired("repo")
+	bbCommitCommentCreateCmd.Flags().String("sha", "", "Commit hash (required)")
+	bbCommitCommentCreateCmd.MarkFlagRequired("sha")
+	bbCommitCommentCreateCmd.Flags().StringP("body", "b", "", "Comment body (required)")
+	bbCommitCommentCreateCmd.MarkFlagRequired("body")
+	bbCommitCommentCreateCmd.Flags().String("path", "", "File path for inline comment")
+	bbCommitCommentCreateCmd.Flags().Int("line", 0, "Line number for inline comment (requires --path)")
+	bbCommitCommentCreateCmd.Flags().Int("parent", 0, "Parent comment ID (for replies)")
+	bbCommitCommentCmd.AddCommand(bbCommitCommentCreateCmd)
+}
+
+func runBBCommitCommentCreate(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+	sha, _ := cmd.Flags().GetString("sha")
+	body, _ := cmd.Flags().GetString("body")
+	path, _ := cmd.Flags().GetString("path")
+	line, _ := cmd.Flags().GetInt("line")
+	parentID, _ := cmd.Flags().GetInt("parent")
+
+	if line > 0 && path == "" {
+		return fmt.Errorf("--line requires --path")
+	}
+
+	req := bitbucket.CreatePRCommentRequest{
+		Content: bitbucket.PRCommentContent{Raw: body},
+	}
+	if parentID > 0 {
+		req.Parent = &bitbucket.PRCommentParent{ID: parentID}
+	}
+	if path != "" {
+		inline := &bitbucket.PRInline{Path: path}
+		if line > 0 {
+			inline.To = &line
+		}
+		req.Inline = inline
+	}
+
+	comment, err := client.CreateCommitComment(workspace, repo, sha, req)
+	if err != nil {
+		return err
+	}
+
+	url := bbCommitURL(workspace, repo, sha)
+	if jsonMode(cmd) {
+		return printJSON(JSONMutationResult{
+			Key: fmt.Sprintf("%d", comment.ID),
+			URL: url,
+		})
+	}
+
+	fmt.Printf("Comment added to commit %s\n", shortHash(sha))
+	fmt.Printf("URL: %s\n", url)
+	return nil
+}
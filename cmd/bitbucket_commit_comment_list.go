@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var bbCommitCommentListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List comments on a commit",
+	RunE:  runBBCommitCommentList,
+}
+
+func init() {
+	bbCommitCommentListCmd.Flags().String("workspace", "", "Workspace slug")
+	bbCommitCommentListCmd.Flags().String("repo", "", "Repository slug (required)")
+	bbCommitCommentListCmd.MarkFlagRequired("repo")
+	bbCommitCommentListCmd.Flags().String("sha", "", "Commit hash (required)")
+	bbCommitCommentListCmd.MarkFlagRequired("sha")
+	bbCommitCommentCmd.AddCommand(bbCommitCommentListCmd)
+}
+
+func runBBCommitCommentList(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+	sha, _ := cmd.Flags().GetString("sha")
+
+	comments, err := client.ListCommitComments(workspace, repo, sha)
+	if err != nil {
+		return err
+	}
+
+	items := make([]JSONInlineCommentItem, 0, len(comments))
+	for _, c := range comments {
+		item := JSONInlineCommentItem{
+			ID:      c.ID,
+			Author:  c.User.DisplayName,
+			Created: c.CreatedOn,
+			Body:    c.Content.Raw,
+		}
+		if c.Parent != nil {
+			item.ParentID = c.Parent.ID
+		}
+		if c.Inline != nil {
+			item.Path, item.From, item.To = c.Inline.Path, c.Inline.From, c.Inline.To
+		}
+		items = append(items, item)
+	}
+
+	if jsonMode(cmd) {
+		return printJSON(items)
+	}
+
+	if len(items) == 0 {
+		fmt.Println("No comments found.")
+		return nil
+	}
+
+	fmt.Printf("Found %d comment(s):\n\n", len(items))
+	for _, c := range items {
+		where := ""
+		if c.Path != "" {
+			where = " on " + c.Path + prInlineLineRef(c.From, c.To)
+		}
+		fmt.Printf("[#%d%s][%s] %s%s:\n%s\n\n", c.ID, replyRef(c.ParentID), c.Created, c.Author, where, c.Body)
+	}
+	return nil
+}
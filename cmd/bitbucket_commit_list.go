@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var bbCommitListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List commits on a branch",
+	Long: "List the commits reachable from --branch (default: the main branch), " +
+		"newest first. --path limits the list to commits touching a file or " +
+		"directory; --since leaves out commits authored before the given " +
+		"RFC 3339 timestamp or YYYY-MM-DD date.",
+	RunE: runBBCommitList,
+}
+
+func init() {
+	bbCommitListCmd.Flags().String("workspace", "", "Workspace slug")
+	bbCommitListCmd.Flags().String("repo", "", "Repository slug (required)")
+	bbCommitListCmd.MarkFlagRequired("repo")
+	bbCommitListCmd.Flags().String("branch", "", "Branch, tag or commit to list from (default: main branch)")
+	bbCommitListCmd.Flags().String("path", "", "Only commits touching this file or directory")
+	bbCommitListCmd.Flags().String("since", "", "Only commits after this RFC 3339 timestamp or date (YYYY-MM-DD)")
+	bbCommitListCmd.Flags().Int("max", 25, "Maximum number of results")
+	bbCommitListCmd.Flags().Bool("all", false, "Return all results, ignoring --max")
+	bbCommitCmd.AddCommand(bbCommitListCmd)
+}
+
+func runBBCommitList(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+	branch, _ := cmd.Flags().GetString("branch")
+	path, _ := cmd.Flags().GetString("path")
+	sinceArg, _ := cmd.Flags().GetString("since")
+	max, _ := cmd.Flags().GetInt("max")
+	all, _ := cmd.Flags().GetBool("all")
+
+	if all {
+		max = 0
+	}
+	var since time.Time
+	if sinceArg != "" {
+		var ok bool
+		if since, ok = parseTimestamp(sinceArg); !ok {
+			return fmt.Errorf("invalid --since %q; use an RFC 3339 timestamp or YYYY-MM-DD", sinceArg)
+		}
+	}
+	if branch == "" {
+		r, err := client.GetRepository(workspace, repo)
+		if err != nil {
+			return err
+		}
+		if r.MainBranch == nil {
+			return fmt.Errorf("repository %s has no main branch; pass --branch", repo)
+		}
+		branch = r.MainBranch.Name
+	}
+
+	commits, err := client.ListCommits(workspace, repo, branch, path, since, max)
+	if err != nil {
+		return err
+	}
+	return printCommitList(cmd, workspace, repo, commits)
+}
@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var bbCommitViewCmd = &cobra.Command{
+	Use:   "view",
+	Short: "Show a commit with its changed files",
+	RunE:  runBBCommitView,
+}
+
+func init() {
+	bbCommitViewCmd.Flags().String("workspace", "", "Workspace slug")
+	bbCommitViewCmd.Flags().String("repo", "", "Repository slug (required)")
+	bbCommitViewCmd.MarkFlagRequired("repo")
+	bbCommitViewCmd.Flags().String("sha", "", "Commit hash (required)")
+	bbCommitViewCmd.MarkFlagRequired("sha")
+	bbCommitCmd.AddCommand(bbCommitViewCmd)
+}
+
+func runBBCommitView(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+	sha, _ := cmd.Flags().GetString("sha")
+
+	commit, err := client.GetCommit(workspace, repo, sha)
+	if err != nil {
+		return err
+	}
+	stats, err := client.ListCommitDiffStat(workspace, repo, commit.Hash)
+	if err != nil {
+		return err
+	}
+
+	detail := JSONCommitDetail{
+		Hash:    commit.Hash,
+		Author:  commitAuthorName(commit.Author),
+		Date:    commit.Date,
+		Message: strings.TrimSpace(commit.Message),
+		Parents: []string{},
+		Files:   make([]JSONDiffStatItem, len(stats)),
+		URL:     bbCommitURL(workspace, repo, commit.Hash),
+	}
+	for _, p := range commit.Parents {
+		detail.Parents = append(detail.Parents, p.Hash)
+	}
+	for i, d := range stats {
+		detail.Files[i] = JSONDiffStatItem{
+			Path:         d.Path(),
+			Status:       d.Status,
+			LinesAdded:   d.LinesAdded,
+			LinesRemoved: d.LinesRemoved,
+		}
+		if d.Old != nil && d.Old.Path != d.Path() {
+			detail.Files[i].OldPath = d.Old.Path
+		}
+	}
+
+	if jsonMode(cmd) {
+		return printJSON(detail)
+	}
+
+	parents := make([]string, len(detail.Parents))
+	for i, p := range detail.Parents {
+		parents[i] = shortHash(p)
+	}
+
+	fmt.Printf("Commit: %s\n", detail.Hash)
+	fmt.Printf("Author: %s\n", commit.Author.Raw)
+	fmt.Printf("Date:   %s\n", detail.Date)
+	if len(parents) > 0 {
+		fmt.Printf("Parents: %s\n", strings.Join(parents, " "))
+	}
+	fmt.Printf("URL:    %s\n", detail.URL)
+	fmt.Println()
+	for _, line := range strings.Split(detail.Message, "\n") {
+		fmt.Printf("    %s\n", line)
+	}
+	fmt.Println()
+	return printDiffStat(cmd, stats, false)
+}
@@ -0,0 +1,29 @@
+package cmd
+
+import (
+	"github.com/novshi-tech/atl-cli/internal/bitbucket"
+	"github.com/spf13/cobra"
+)
+
+var bbPermissionsCmd = &cobra.Command{
+	Use:   "permissions",
+	Short: "Manage repository permissions",
+	Long: "Manage the explicit user and group permissions on repositories. grant " +
+		"and revoke accept --repo, --user and --group more than once, e.g. to " +
+		"give a new teammate access to every repository they need in one go.",
+}
+
+func init() {
+	bitbucketCmd.AddCommand(bbPermissionsCmd)
+}
+
+func toJSONRepoPermission(repo string, p bitbucket.RepoPermission) JSONRepoPermission {
+	item := JSONRepoPermission{Repo: repo, Permission: p.Permission}
+	if p.User != nil {
+		item.Type, item.Name, item.ID = "user", p.User.DisplayName, p.User.UUID
+	}
+	if p.Group != nil {
+		item.Type, item.Name, item.ID = "group", p.Group.Name, p.Group.Slug
+	}
+	return item
+}
@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/novshi-tech/atl-cli/internal/bitbucket"
+	"github.com/spf13/cobra"
+)
+
+var bbPermissionsGrantCmd = &cobra.Command{
+	Use:   "grant",
+	Short: "Grant users or groups a permission on repositories",
+	Long: "Grant each --user and --group the --level permission on each --repo, " +
+		"replacing any explicit permission they already had there.",
+	RunE: runBBPermissionsGrant,
+}
+
+func init() {
+	bbPermissionsGrantCmd.Flags().String("workspace", "", "Workspace slug")
+	bbPermissionsGrantCmd.Flags().StringSlice("repo", nil, "Repository slug (required, repeatable)")
+	bbPermissionsGrantCmd.MarkFlagRequired("repo")
+	bbPermissionsGrantCmd.Flags().StringSlice("user", nil, "User: \"me\", nickname, display name, account ID or UUID (repeatable)")
+	bbPermissionsGrantCmd.Flags().StringSlice("group", nil, "Group slug (repeatable)")
+	bbPermissionsGrantCmd.MarkFlagsOneRequired("user", "group")
+	bbPermissionsGrantCmd.Flags().String("level", "", "Permission level: read, write or admin (required)")
+	bbPermissionsGrantCmd.MarkFlagRequired("level")
+	bbPermissionsCmd.AddCommand(bbPermissionsGrantCmd)
+}
+
+func runBBPermissionsGrant(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repos, _ := cmd.Flags().GetStringSlice("repo")
+	userRefs, _ := cmd.Flags().GetStringSlice("user")
+	groups, _ := cmd.Flags().GetStringSlice("group")
+	level, _ := cmd.Flags().GetString("level")
+
+	level = strings.ToLower(level)
+	if !slices.Contains(bitbucket.PermissionLevels, level) {
+		return fmt.Errorf("invalid --level %q; must be one of: %s", level, strings.Join(bitbucket.PermissionLevels, ", "))
+	}
+
+	uuids, err := resolveBBUserUUIDs(client, workspace, userRefs, map[string]string{})
+	if err != nil {
+		return err
+	}
+
+	results := []JSONRepoPermission{}
+	for _, repo := range repos {
+		for i, uuid := range uuids {
+			if err := client.SetRepoUserPermission(workspace, repo, uuid, level); err != nil {
+				return fmt.Errorf("%s: user %s: %w", repo, userRefs[i], err)
+			}
+			results = append(results, JSONRepoPermission{Repo: repo, Type: "user", Name: userRefs[i], ID: uuid, Permission: level})
+			if !jsonMode(cmd) {
+				fmt.Printf("Granted user %s %s access to %s\n", userRefs[i], level, repo)
+			}
+		}
+		for _, g := range groups {
+			if err := client.SetRepoGroupPermission(workspace, repo, g, level); err != nil {
+				return fmt.Errorf("%s: group %s: %w", repo, g, err)
+			}
+			results = append(results, JSONRepoPermission{Repo: repo, Type: "group", Name: g, ID: g, Permission: level})
+			if !jsonMode(cmd) {
+				fmt.Printf("Granted group %s %s access to %s\n", g, level, repo)
+			}
+		}
+	}
+
+	if jsonMode(cmd) {
+		return printJSON(results)
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var bbPermissionsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List repository permissions",
+	Long: "With --repo, list the users and groups with explicit permissions on the " +
+		"repository. With --user and no --repo, list that user's permissions on " +
+		"every repository in the workspace.",
+	RunE: runBBPermissionsList,
+}
+
+func init() {
+	bbPermissionsListCmd.Flags().String("workspace", "", "Workspace slug")
+	bbPermissionsListCmd.Flags().String("repo", "", "Repository slug")
+	bbPermissionsListCmd.Flags().String("user", "", "User: \"me\", nickname, display name, account ID or UUID")
+	bbPermissionsListCmd.MarkFlagsOneRequired("repo", "user")
+	bbPermissionsCmd.AddCommand(bbPermissionsListCmd)
+}
+
+func runBBPermissionsList(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+	userRef, _ := cmd.Flags().GetString("user")
+
+	var userUUID string
+	if userRef != "" {
+		if userUUID, err = resolveBBUserUUID(client, workspace, userRef); err != nil {
+			return err
+		}
+	}
+
+	items := []JSONRepoPermission{}
+	if repo == "" {
+		perms, err := client.ListUserRepoPermissions(workspace, userUUID)
+		if err != nil {
+			return err
+		}
+		for _, p := range perms {
+			name := ""
+			if p.Repository != nil {
+				name = strings.TrimPrefix(p.Repository.FullName, workspace+"/")
+			}
+			items = append(items, toJSONRepoPermission(name, p))
+		}
+	} else {
+		users, err := client.ListRepoUserPermissions(workspace, repo)
+		if err != nil {
+			return err
+		}
+		groups, err := client.ListRepoGroupPermissions(workspace, repo)
+		if err != nil {
+			return err
+		}
+		for _, p := range append(users, groups...) {
+			item := toJSONRepoPermission("", p)
+			if userUUID != "" && item.ID != userUUID {
+				continue
+			}
+			items = append(items, item)
+		}
+	}
+
+	if jsonMode(cmd) {
+		return printJSON(items)
+	}
+
+	if len(items) == 0 {
+		fmt.Println("No permissions found.")
+		return nil
+	}
+
+	fmt.Printf("Found %d permission(s):\n\n", len(items))
+	for _, p := range items {
+		if repo == "" {
+			fmt.Printf("%-30s  %s\n", p.Repo, p.Permission)
+		} else {
+			fmt.Printf("%-6s  %-30s  %s\n", p.Type, p.Name, p.Permission)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var bbPermissionsRevokeCmd = &cobra.Command{
+	Use:   "revoke",
+	Short: "Revoke the explicit permissions of users or groups on repositories",
+	Long: "Remove the explicit permission of each --user and --group on each " +
+		"--repo. Access they have through the workspace or a project is not " +
+		"affected.",
+	RunE: runBBPermissionsRevoke,
+}
+
+func init() {
+	bbPermissionsRevokeCmd.Flags().String("workspace", "", "Workspace slug")
+	bbPermissionsRevokeCmd.Flags().StringSlice("repo", nil, "Repository slug (required, repeatable)")
+	bbPermissionsRevokeCmd.MarkFlagRequired("repo")
+	bbPermissionsRevokeCmd.Flags().StringSlice("user", nil, "User: \"me\", nickname, display name, account ID or UUID (repeatable)")
+	bbPermissionsRevokeCmd.Flags().StringSlice("group", nil, "Group slug (repeatable)")
+	bbPermissionsRevokeCmd.MarkFlagsOneRequired("user", "group")
+	bbPermissionsRevokeCmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+	bbPermissionsCmd.AddCommand(bbPermissionsRevokeCmd)
+}
+
+func runBBPermissionsRevoke(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repos, _ := cmd.Flags().GetStringSlice("repo")
+	userRefs, _ := cmd.Flags().GetStringSlice("user")
+	groups, _ := cmd.Flags().GetStringSlice("group")
+
+	uuids, err := resolveBBUserUUIDs(client, workspace, userRefs, map[string]string{})
+	if err != nil {
+		return err
+	}
+
+	prompt := fmt.Sprintf("Revoke the permissions of %d user(s) and %d group(s) on %d repositor(ies)?",
+		len(userRefs), len(groups), len(repos))
+	if err := confirmDestructive(cmd, prompt); err != nil {
+		return err
+	}
+
+	results := []JSONRepoPermission{}
+	for _, repo := range repos {
+		for i, uuid := range uuids {
+			if err := client.DeleteRepoUserPermission(workspace, repo, uuid); err != nil {
+				return fmt.Errorf("%s: user %s: %w", repo, userRefs[i], err)
+			}
+			results = append(results, JSONRepoPermission{Repo: repo, Type: "user", Name: userRefs[i], ID: uuid})
+			if !jsonMode(cmd) {
+				fmt.Printf("Revoked user %s's access to %s\n", userRefs[i], repo)
+			}
+		}
+		for _, g := range groups {
+			if err := client.DeleteRepoGroupPermission(workspace, repo, g); err != nil {
+				return fmt.Errorf("%s: group %s: %w", repo, g, err)
+			}
+			results = append(results, JSONRepoPermission{Repo: repo, Type: "group", Name: g, ID: g})
+			if !jsonMode(cmd) {
+				fmt.Printf("Revoked group %s's access to %s\n", g, repo)
+			}
+		}
+	}
+
+	if jsonMode(cmd) {
+		return printJSON(results)
+	}
+	return nil
+}
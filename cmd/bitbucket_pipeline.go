@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/novshi-tech/atl-cli/internal/bitbucket"
+	"github.com/spf13/cobra"
+)
+
+var bbPipelineCmd = &cobra.Command{
+	Use:   "pipeline",
+	Short: "Manage Bitbucket Pipelines",
+}
+
+func init() {
+	bitbucketCmd.AddCommand(bbPipelineCmd)
+}
+
+// pipelinePollInterval is how often --wait and log streaming poll a
+// running pipeline.
+const pipelinePollInterval = 5 * time.Second
+
+// bbPipelineURL returns the web URL of a pipeline run.
+func bbPipelineURL(workspace, repo string, buildNumber int) string {
+	return fmt.Sprintf("https://bitbucket.org/%s/%s/pipelines/results/%d", workspace, repo, buildNumber)
+}
+
+// waitForPipeline polls a pipeline until it completes, reporting state
+// changes on stderr, and returns it in its final state.
+func waitForPipeline(client *bitbucket.Client, workspace, repo, id string) (*bitbucket.Pipeline, error) {
+	last := ""
+	for {
+		p, err := client.GetPipeline(workspace, repo, id)
+		if err != nil {
+			return nil, err
+		}
+		if status := p.State.Status(); status != last {
+			fmt.Fprintf(os.Stderr, "Pipeline #%d: %s\n", p.BuildNumber, status)
+			last = status
+		}
+		if p.State.Done() {
+			return p, nil
+		}
+		time.Sleep(pipelinePollInterval)
+	}
+}
+
+// pipelineResultError returns an error unless a completed pipeline
+// succeeded, so that --wait exits non-zero on failure.
+func pipelineResultError(cmd *cobra.Command, p *bitbucket.Pipeline) error {
+	if status := p.State.Status(); status != "SUCCESSFUL" {
+		cmd.SilenceUsage = true
+		return fmt.Errorf("pipeline #%d finished with result %s", p.BuildNumber, status)
+	}
+	return nil
+}
+
+func toJSONPipelineItem(workspace, repo string, p bitbucket.Pipeline) JSONPipelineItem {
+	item := JSONPipelineItem{
+		BuildNumber:     p.BuildNumber,
+		UUID:            p.UUID,
+		State:           p.State.Name,
+		Status:          p.State.Status(),
+		RefType:         p.Target.RefType,
+		RefName:         p.Target.RefName,
+		Trigger:         p.Trigger.Name,
+		CreatedOn:       p.CreatedOn,
+		CompletedOn:     p.CompletedOn,
+		DurationSeconds: p.DurationInSeconds,
+		URL:             bbPipelineURL(workspace, repo, p.BuildNumber),
+	}
+	if p.Target.Commit != nil {
+		item.Commit = p.Target.Commit.Hash
+	}
+	if p.Target.Selector != nil && p.Target.Selector.Type != "default" {
+		item.Pipeline = p.Target.Selector.Type
+		if p.Target.Selector.Pattern != "" {
+			item.Pipeline += ": " + p.Target.Selector.Pattern
+		}
+	}
+	if p.Creator != nil {
+		item.Creator = p.Creator.DisplayName
+	}
+	return item
+}
+
+// pipelineTargetDesc describes what a pipeline ran on, e.g.
+// "branch main @ 1a2b3c4d5e6f".
+func pipelineTargetDesc(item JSONPipelineItem) string {
+	var parts []string
+	if item.RefName != "" {
+		parts = append(parts, item.RefType+" "+item.RefName)
+	}
+	if item.Commit != "" {
+		parts = append(parts, shortHash(item.Commit))
+	}
+	desc := strings.Join(parts, " @ ")
+	if item.Pipeline != "" {
+		desc += " (" + item.Pipeline + ")"
+	}
+	return desc
+}
+
+func shortHash(hash string) string {
+	if len(hash) > 12 {
+		return hash[:12]
+	}
+	return hash
+}
+
+func formatSeconds(seconds int) string {
+	return (time.Duration(seconds) * time.Second).String()
+}
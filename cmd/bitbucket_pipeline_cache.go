@@ -0,0 +1,160 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var bbPipelineCacheCmd = &cobra.Command{
+	Use:   "cache",
+	Short: "Manage pipeline caches",
+}
+
+var bbPipelineCacheListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List pipeline caches",
+	RunE:  runBBPipelineCacheList,
+}
+
+var bbPipelineCacheClearCmd = &cobra.Command{
+	Use:   "clear",
+	Short: "Delete pipeline caches",
+	Long:  "Delete the pipeline caches named by --name, or all of them with --all (after confirmation).",
+	RunE:  runBBPipelineCacheClear,
+}
+
+func init() {
+	for _, c := range []*cobra.Command{bbPipelineCacheListCmd, bbPipelineCacheClearCmd} {
+		c.Flags().String("workspace", "", "Workspace slug")
+		c.Flags().String("repo", "", "Repository slug (required)")
+		c.MarkFlagRequired("repo")
+		bbPipelineCacheCmd.AddCommand(c)
+	}
+	bbPipelineCacheClearCmd.Flags().StringSlice("name", nil, "Cache name to clear (repeatable)")
+	bbPipelineCacheClearCmd.Flags().Bool("all", false, "Clear every cache")
+	bbPipelineCacheClearCmd.MarkFlagsMutuallyExclusive("name", "all")
+	bbPipelineCacheClearCmd.MarkFlagsOneRequired("name", "all")
+	bbPipelineCacheClearCmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt for --all")
+	bbPipelineCmd.AddCommand(bbPipelineCacheCmd)
+}
+
+func runBBPipelineCacheList(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+
+	caches, err := client.ListPipelineCaches(workspace, repo)
+	if err != nil {
+		return err
+	}
+
+	if jsonMode(cmd) {
+		items := make([]JSONPipelineCache, len(caches))
+		for i, c := range caches {
+			items[i] = JSONPipelineCache{
+				UUID:          c.UUID,
+				Name:          c.Name,
+				Path:          c.Path,
+				FileSizeBytes: c.FileSizeBytes,
+				CreatedOn:     c.CreatedOn,
+			}
+		}
+		return printJSON(items)
+	}
+
+	if len(caches) == 0 {
+		fmt.Println("No caches found.")
+		return nil
+	}
+
+	fmt.Printf("Found %d cache(s):\n\n", len(caches))
+	for _, c := range caches {
+		fmt.Printf("%-20s  %10s  %s  %s\n", c.Name, formatBytes(c.FileSizeBytes), c.CreatedOn, c.Path)
+	}
+	return nil
+}
+
+func runBBPipelineCacheClear(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+	names, _ := cmd.Flags().GetStringSlice("name")
+	all, _ := cmd.Flags().GetBool("all")
+
+	caches, err := client.ListPipelineCaches(workspace, repo)
+	if err != nil {
+		return err
+	}
+
+	existing := make(map[string]bool)
+	for _, c := range caches {
+		existing[c.Name] = true
+	}
+	want := make(map[string]bool)
+	for _, n := range names {
+		if !existing[n] {
+			return fmt.Errorf("no cache named %q", n)
+		}
+		want[n] = true
+	}
+	if all && len(caches) > 0 {
+		if err := confirmDestructive(cmd, fmt.Sprintf("Clear all %d cache(s) in %s?", len(caches), repo)); err != nil {
+			return err
+		}
+	}
+
+	cleared := []string{}
+	for _, c := range caches {
+		if !all && !want[c.Name] {
+			continue
+		}
+		if err := client.DeletePipelineCache(workspace, repo, c.UUID); err != nil {
+			return fmt.Errorf("clearing cache %s: %w", c.Name, err)
+		}
+		cleared = append(cleared, c.Name)
+	}
+
+	if jsonMode(cmd) {
+		return printJSON(struct {
+			Cleared []string `json:"cleared"`
+		}{cleared})
+	}
+
+	if len(cleared) == 0 {
+		fmt.Println("No caches to clear.")
+		return nil
+	}
+	for _, n := range cleared {
+		fmt.Printf("Cleared cache %s\n", n)
+	}
+	return nil
+}
+
+// formatBytes renders n with a binary unit, e.g. "12.3 MiB".
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
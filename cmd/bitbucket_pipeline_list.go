@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var bbPipelineListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List recent pipelines in a repository",
+	RunE:  runBBPipelineList,
+}
+
+func init() {
+	bbPipelineListCmd.Flags().String("workspace", "", "Workspace slug")
+	bbPipelineListCmd.Flags().String("repo", "", "Repository slug (required)")
+	bbPipelineListCmd.MarkFlagRequired("repo")
+	bbPipelineListCmd.Flags().Int("max", 25, "Maximum number of results")
+	bbPipelineCmd.AddCommand(bbPipelineListCmd)
+}
+
+func runBBPipelineList(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+	max, _ := cmd.Flags().GetInt("max")
+
+	pipelines, err := client.ListPipelines(workspace, repo, max)
+	if err != nil {
+		return err
+	}
+
+	items := make([]JSONPipelineItem, len(pipelines))
+	for i, p := range pipelines {
+		items[i] = toJSONPipelineItem(workspace, repo, p)
+	}
+
+	if jsonMode(cmd) {
+		return printJSON(items)
+	}
+
+	if len(items) == 0 {
+		fmt.Println("No pipelines found.")
+		return nil
+	}
+
+	fmt.Printf("Found %d pipeline(s):\n\n", len(items))
+	for _, p := range items {
+		fmt.Printf("#%-6d  %-11s  %-20s  %-8s  %s\n",
+			p.BuildNumber, p.Status, p.CreatedOn, formatSeconds(p.DurationSeconds), pipelineTargetDesc(p))
+	}
+	return nil
+}
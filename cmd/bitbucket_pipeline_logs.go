@@ -0,0 +1,133 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/novshi-tech/atl-cli/internal/bitbucket"
+	"github.com/spf13/cobra"
+)
+
+var bbPipelineLogsCmd = &cobra.Command{
+	Use:   "logs",
+	Short: "Print the log of a pipeline step",
+	Long: "Print the log of a pipeline step. If the step is still running, the " +
+		"log is streamed until the step finishes. Without --step, the logs of " +
+		"all steps are printed in order, each preceded by a header line.",
+	RunE: runBBPipelineLogs,
+}
+
+func init() {
+	bbPipelineLogsCmd.Flags().String("workspace", "", "Workspace slug")
+	bbPipelineLogsCmd.Flags().String("repo", "", "Repository slug (required)")
+	bbPipelineLogsCmd.MarkFlagRequired("repo")
+	bbPipelineLogsCmd.Flags().String("id", "", "Pipeline build number or UUID (required)")
+	bbPipelineLogsCmd.MarkFlagRequired("id")
+	bbPipelineLogsCmd.Flags().String("step", "", "Step name or UUID (default: all steps)")
+	bbPipelineCmd.AddCommand(bbPipelineLogsCmd)
+}
+
+func runBBPipelineLogs(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+	id, _ := cmd.Flags().GetString("id")
+	stepRef, _ := cmd.Flags().GetString("step")
+
+	p, err := client.GetPipeline(workspace, repo, id)
+	if err != nil {
+		return err
+	}
+	steps, err := client.ListPipelineSteps(workspace, repo, p.UUID)
+	if err != nil {
+		return err
+	}
+
+	if stepRef != "" {
+		step, err := findPipelineStep(steps, stepRef)
+		if err != nil {
+			return err
+		}
+		return streamStepLog(client, workspace, repo, p.UUID, step)
+	}
+
+	for i, step := range steps {
+		if i > 0 {
+			fmt.Println()
+		}
+		fmt.Printf("==> %s <==\n", step.Name)
+		if err := streamStepLog(client, workspace, repo, p.UUID, step); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func findPipelineStep(steps []bitbucket.PipelineStep, ref string) (bitbucket.PipelineStep, error) {
+	for _, s := range steps {
+		if s.UUID == ref || s.UUID == "{"+ref+"}" {
+			return s, nil
+		}
+	}
+	var matches []bitbucket.PipelineStep
+	for _, s := range steps {
+		if strings.EqualFold(s.Name, ref) {
+			matches = append(matches, s)
+		}
+	}
+	if len(matches) == 1 {
+		return matches[0], nil
+	}
+
+	names := make([]string, len(steps))
+	for i, s := range steps {
+		names[i] = fmt.Sprintf("%q %s", s.Name, s.UUID)
+	}
+	if len(matches) > 1 {
+		return bitbucket.PipelineStep{}, fmt.Errorf("%d steps are named %q; use the step UUID: %s", len(matches), ref, strings.Join(names, ", "))
+	}
+	return bitbucket.PipelineStep{}, fmt.Errorf("no step %q; steps: %s", ref, strings.Join(names, ", "))
+}
+
+// streamStepLog writes a step's log to stdout. While the step is still
+// running, it keeps fetching whatever was appended since the last read
+// until the step finishes.
+func streamStepLog(client *bitbucket.Client, workspace, repo, pipelineUUID string, step bitbucket.PipelineStep) error {
+	var offset int64
+	for {
+		done := step.State.Done()
+		chunk, err := client.GetPipelineStepLog(workspace, repo, pipelineUUID, step.UUID, offset)
+		if err != nil {
+			return err
+		}
+		if _, err := os.Stdout.Write(chunk); err != nil {
+			return err
+		}
+		offset += int64(len(chunk))
+		// Fetch once more after the step is seen to finish, so the tail
+		// written before completion isn't lost.
+		if done {
+			return nil
+		}
+
+		time.Sleep(pipelinePollInterval)
+		steps, err := client.ListPipelineSteps(workspace, repo, pipelineUUID)
+		if err != nil {
+			return err
+		}
+		for _, s := range steps {
+			if s.UUID == step.UUID {
+				step = s
+			}
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/novshi-tech/atl-cli/internal/bitbucket"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+var bbPipelineRunCmd = &cobra.Command{
+	Use:   "run",
+	Short: "Trigger a pipeline",
+	Long: "Trigger a pipeline on a branch (default: the repository's main branch) " +
+		"or commit. --custom runs a custom pipeline defined in bitbucket-pipelines.yml. " +
+		"The values of --secret-var variables are read from stdin, prompted for " +
+		"without echo on a terminal or one per line otherwise, which keeps them " +
+		"out of shell history. " +
+		"With --wait, the command blocks until the pipeline finishes and exits " +
+		"non-zero unless it succeeded.",
+	RunE: runBBPipelineRun,
+}
+
+func init() {
+	bbPipelineRunCmd.Flags().String("workspace", "", "Workspace slug")
+	bbPipelineRunCmd.Flags().String("repo", "", "Repository slug (required)")
+	bbPipelineRunCmd.MarkFlagRequired("repo")
+	bbPipelineRunCmd.Flags().String("branch", "", "Branch to run on (default: main branch unless --commit is given)")
+	bbPipelineRunCmd.Flags().String("commit", "", "Commit hash to run on")
+	bbPipelineRunCmd.Flags().String("custom", "", "Name of a custom pipeline to run")
+	bbPipelineRunCmd.Flags().StringArray("var", nil, "Pipeline variable as KEY=VALUE (repeatable)")
+	bbPipelineRunCmd.Flags().StringArray("secret-var", nil, "Secured pipeline variable KEY, its value read from stdin (repeatable)")
+	bbPipelineRunCmd.Flags().Bool("wait", false, "Wait for the pipeline to finish; exit non-zero if it fails")
+	bbPipelineCmd.AddCommand(bbPipelineRunCmd)
+}
+
+func runBBPipelineRun(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+	branch, _ := cmd.Flags().GetString("branch")
+	commit, _ := cmd.Flags().GetString("commit")
+	custom, _ := cmd.Flags().GetString("custom")
+	vars, _ := cmd.Flags().GetStringArray("var")
+	secretVars, _ := cmd.Flags().GetStringArray("secret-var")
+	wait, _ := cmd.Flags().GetBool("wait")
+
+	var req bitbucket.RunPipelineRequest
+	for _, v := range vars {
+		pv, err := parsePipelineVariable(v)
+		if err != nil {
+			return err
+		}
+		req.Variables = append(req.Variables, pv)
+	}
+	secretValues, err := readSecretVariables(secretVars)
+	if err != nil {
+		return err
+	}
+	for i, key := range secretVars {
+		req.Variables = append(req.Variables, bitbucket.PipelineVariable{Key: key, Value: secretValues[i], Secured: true})
+	}
+
+	if branch == "" && commit == "" {
+		r, err := client.GetRepository(workspace, repo)
+		if err != nil {
+			return err
+		}
+		if r.MainBranch == nil {
+			return fmt.Errorf("repository %s has no main branch; pass --branch or --commit", repo)
+		}
+		branch = r.MainBranch.Name
+	}
+
+	if branch != "" {
+		req.Target = bitbucket.PipelineTarget{Type: "pipeline_ref_target", RefType: "branch", RefName: branch}
+	} else {
+		req.Target = bitbucket.PipelineTarget{Type: "pipeline_commit_target"}
+	}
+	if commit != "" {
+		req.Target.Commit = &bitbucket.PipelineCommit{Type: "commit", Hash: commit}
+	}
+	if custom != "" {
+		req.Target.Selector = &bitbucket.PipelineSelector{Type: "custom", Pattern: custom}
+	}
+
+	p, err := client.RunPipeline(workspace, repo, req)
+	if err != nil {
+		return err
+	}
+	pipelineURL := bbPipelineURL(workspace, repo, p.BuildNumber)
+
+	if !wait {
+		if jsonMode(cmd) {
+			return printJSON(JSONMutationResult{
+				Key: fmt.Sprintf("%d", p.BuildNumber),
+				URL: pipelineURL,
+			})
+		}
+		fmt.Printf("Started pipeline #%d\n", p.BuildNumber)
+		fmt.Printf("URL: %s\n", pipelineURL)
+		return nil
+	}
+
+	if !jsonMode(cmd) {
+		fmt.Printf("Started pipeline #%d\n", p.BuildNumber)
+		fmt.Printf("URL: %s\n", pipelineURL)
+	}
+	p, err = waitForPipeline(client, workspace, repo, p.UUID)
+	if err != nil {
+		return err
+	}
+	if jsonMode(cmd) {
+		if err := printJSON(toJSONPipelineItem(workspace, repo, *p)); err != nil {
+			return err
+		}
+	} else {
+		fmt.Printf("Pipeline #%d finished: %s (%s)\n", p.BuildNumber, p.State.Status(), formatSeconds(p.DurationInSeconds))
+	}
+	return pipelineResultError(cmd, p)
+}
+
+func parsePipelineVariable(s string) (bitbucket.PipelineVariable, error) {
+	key, value, ok := strings.Cut(s, "=")
+	if !ok || key == "" {
+		return bitbucket.PipelineVariable{}, fmt.Errorf("invalid variable %q; expected KEY=VALUE", s)
+	}
+	return bitbucket.PipelineVariable{Key: key, Value: value}, nil
+}
+
+// readSecretVariables reads the values of the secured variables keys from
+// stdin: each with a no-echo prompt on a terminal, otherwise all of stdin
+// for a single key, or one line per key.
+func readSecretVariables(keys []string) ([]string, error) {
+	for _, key := range keys {
+		if key == "" || strings.Contains(key, "=") {
+			return nil, fmt.Errorf("invalid --secret-var %q; give only the KEY, the value is read from stdin", key)
+		}
+	}
+	if len(keys) <= 1 || term.IsTerminal(int(os.Stdin.Fd())) {
+		values := make([]string, len(keys))
+		for i, key := range keys {
+			v, err := readVariableValue(key)
+			if err != nil {
+				return nil, err
+			}
+			values[i] = v
+		}
+		return values, nil
+	}
+
+	data, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		return nil, fmt.Errorf("reading stdin: %w", err)
+	}
+	lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	if len(lines) != len(keys) {
+		return nil, fmt.Errorf("expected %d lines on stdin, one per --secret-var, got %d", len(keys), len(lines))
+	}
+	for i := range lines {
+		lines[i] = strings.TrimSuffix(lines[i], "\r")
+	}
+	return lines, nil
+}
@@ -0,0 +1,29 @@
+package cmd
+
+import (
+	"github.com/novshi-tech/atl-cli/internal/bitbucket"
+	"github.com/spf13/cobra"
+)
+
+var bbPipelineScheduleCmd = &cobra.Command{
+	Use:   "schedule",
+	Short: "Manage scheduled pipelines",
+}
+
+func init() {
+	bbPipelineCmd.AddCommand(bbPipelineScheduleCmd)
+}
+
+func toJSONPipelineSchedule(s bitbucket.PipelineSchedule) JSONPipelineSchedule {
+	item := JSONPipelineSchedule{
+		UUID:        s.UUID,
+		Enabled:     s.Enabled,
+		CronPattern: s.CronPattern,
+		Branch:      s.Target.RefName,
+		CreatedOn:   s.CreatedOn,
+	}
+	if s.Target.Selector != nil && s.Target.Selector.Type == "custom" {
+		item.Pipeline = s.Target.Selector.Pattern
+	}
+	return item
+}
@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/novshi-tech/atl-cli/internal/bitbucket"
+	"github.com/spf13/cobra"
+)
+
+var bbPipelineScheduleCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Schedule a pipeline",
+	Long: "Schedule a pipeline to run periodically on a branch. --cron is a Quartz " +
+		"cron expression evaluated in UTC, with seconds first, e.g. \"0 0 2 * * ? *\" " +
+		"for every day at 02:00.",
+	RunE: runBBPipelineScheduleCreate,
+}
+
+func init() {
+	bbPipelineScheduleCreateCmd.Flags().String("workspace", "", "Workspace slug")
+	bbPipelineScheduleCreateCmd.Flags().String("repo", "", "Repository slug (required)")
+	bbPipelineScheduleCreateCmd.MarkFlagRequired("repo")
+	bbPipelineScheduleCreateCmd.Flags().String("branch", "", "Branch to run on (required)")
+	bbPipelineScheduleCreateCmd.MarkFlagRequired("branch")
+	bbPipelineScheduleCreateCmd.Flags().String("cron", "", "Quartz cron expression in UTC (required)")
+	bbPipelineScheduleCreateCmd.MarkFlagRequired("cron")
+	bbPipelineScheduleCreateCmd.Flags().String("custom", "", "Name of a custom pipeline to run (default: the branch pipeline)")
+	bbPipelineScheduleCreateCmd.Flags().Bool("disabled", false, "Create the schedule disabled")
+	bbPipelineScheduleCmd.AddCommand(bbPipelineScheduleCreateCmd)
+}
+
+func runBBPipelineScheduleCreate(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+	branch, _ := cmd.Flags().GetString("branch")
+	cron, _ := cmd.Flags().GetString("cron")
+	custom, _ := cmd.Flags().GetString("custom")
+	disabled, _ := cmd.Flags().GetBool("disabled")
+
+	selector := &bitbucket.PipelineSelector{Type: "branches", Pattern: branch}
+	if custom != "" {
+		selector = &bitbucket.PipelineSelector{Type: "custom", Pattern: custom}
+	}
+	schedule, err := client.CreatePipelineSchedule(workspace, repo, bitbucket.PipelineSchedule{
+		Enabled:     !disabled,
+		CronPattern: cron,
+		Target: bitbucket.PipelineTarget{
+			Type:     "pipeline_ref_target",
+			RefType:  "branch",
+			RefName:  branch,
+			Selector: selector,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	if jsonMode(cmd) {
+		return printJSON(toJSONPipelineSchedule(*schedule))
+	}
+
+	fmt.Printf("Created schedule %s\n", schedule.UUID)
+	return nil
+}
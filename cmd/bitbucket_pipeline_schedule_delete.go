@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var bbPipelineScheduleDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete a scheduled pipeline",
+	RunE:  runBBPipelineScheduleDelete,
+}
+
+func init() {
+	bbPipelineScheduleDeleteCmd.Flags().String("workspace", "", "Workspace slug")
+	bbPipelineScheduleDeleteCmd.Flags().String("repo", "", "Repository slug (required)")
+	bbPipelineScheduleDeleteCmd.MarkFlagRequired("repo")
+	bbPipelineScheduleDeleteCmd.Flags().String("id", "", "Schedule UUID (required)")
+	bbPipelineScheduleDeleteCmd.MarkFlagRequired("id")
+	bbPipelineScheduleDeleteCmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+	bbPipelineScheduleCmd.AddCommand(bbPipelineScheduleDeleteCmd)
+}
+
+func runBBPipelineScheduleDelete(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+	id, _ := cmd.Flags().GetString("id")
+
+	if err := confirmDestructive(cmd, fmt.Sprintf("Delete schedule %s?", id)); err != nil {
+		return err
+	}
+	if err := client.DeletePipelineSchedule(workspace, repo, id); err != nil {
+		return err
+	}
+
+	if jsonMode(cmd) {
+		return printJSON(JSONMutationResult{Key: id})
+	}
+
+	fmt.Printf("Deleted schedule %s\n", id)
+	return nil
+}
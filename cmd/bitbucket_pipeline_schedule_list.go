@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var bbPipelineScheduleListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List scheduled pipelines",
+	RunE:  runBBPipelineScheduleList,
+}
+
+func init() {
+	bbPipelineScheduleListCmd.Flags().String("workspace", "", "Workspace slug")
+	bbPipelineScheduleListCmd.Flags().String("repo", "", "Repository slug (required)")
+	bbPipelineScheduleListCmd.MarkFlagRequired("repo")
+	bbPipelineScheduleCmd.AddCommand(bbPipelineScheduleListCmd)
+}
+
+func runBBPipelineScheduleList(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+
+	schedules, err := client.ListPipelineSchedules(workspace, repo)
+	if err != nil {
+		return err
+	}
+
+	items := make([]JSONPipelineSchedule, len(schedules))
+	for i, s := range schedules {
+		items[i] = toJSONPipelineSchedule(s)
+	}
+
+	if jsonMode(cmd) {
+		return printJSON(items)
+	}
+
+	if len(items) == 0 {
+		fmt.Println("No schedules found.")
+		return nil
+	}
+
+	fmt.Printf("Found %d schedule(s):\n\n", len(items))
+	for _, s := range items {
+		enabled := "enabled"
+		if !s.Enabled {
+			enabled = "disabled"
+		}
+		pipeline := "default"
+		if s.Pipeline != "" {
+			pipeline = "custom: " + s.Pipeline
+		}
+		fmt.Printf("%s  %-8s  %-20s  %s (%s)\n", s.UUID, enabled, s.CronPattern, s.Branch, pipeline)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var bbPipelineStopCmd = &cobra.Command{
+	Use:   "stop",
+	Short: "Stop a running pipeline",
+	RunE:  runBBPipelineStop,
+}
+
+func init() {
+	bbPipelineStopCmd.Flags().String("workspace", "", "Workspace slug")
+	bbPipelineStopCmd.Flags().String("repo", "", "Repository slug (required)")
+	bbPipelineStopCmd.MarkFlagRequired("repo")
+	bbPipelineStopCmd.Flags().String("id", "", "Pipeline build number or UUID (required)")
+	bbPipelineStopCmd.MarkFlagRequired("id")
+	bbPipelineCmd.AddCommand(bbPipelineStopCmd)
+}
+
+func runBBPipelineStop(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+	id, _ := cmd.Flags().GetString("id")
+
+	// Resolve the pipeline first so that a build number works and the
+	// output can refer to it.
+	p, err := client.GetPipeline(workspace, repo, id)
+	if err != nil {
+		return err
+	}
+	if err := client.StopPipeline(workspace, repo, p.UUID); err != nil {
+		return err
+	}
+
+	pipelineURL := bbPipelineURL(workspace, repo, p.BuildNumber)
+
+	if jsonMode(cmd) {
+		return printJSON(JSONMutationResult{
+			Key: fmt.Sprintf("%d", p.BuildNumber),
+			URL: pipelineURL,
+		})
+	}
+
+	fmt.Printf("Stopping pipeline #%d\n", p.BuildNumber)
+	fmt.Printf("URL: %s\n", pipelineURL)
+	return nil
+}
@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/novshi-tech/atl-cli/internal/bitbucket"
+	"github.com/spf13/cobra"
+)
+
+var bbPipelineVariableCmd = &cobra.Command{
+	Use:   "variable",
+	Short: "Manage repository and deployment variables",
+	Long: "Manage Pipelines variables. Without --environment, the commands act on " +
+		"repository variables; with it, on the variables of that deployment " +
+		"environment (given by name, slug or UUID).",
+}
+
+func init() {
+	bbPipelineCmd.AddCommand(bbPipelineVariableCmd)
+}
+
+// resolveEnvironmentUUID resolves a deployment environment by UUID, name
+// or slug; an empty ref resolves to "" (repository variables).
+func resolveEnvironmentUUID(client *bitbucket.Client, workspace, repo, ref string) (string, error) {
+	if ref == "" {
+		return "", nil
+	}
+	envs, err := client.ListEnvironments(workspace, repo)
+	if err != nil {
+		return "", err
+	}
+	names := make([]string, len(envs))
+	for i, e := range envs {
+		if e.UUID == ref || e.UUID == "{"+ref+"}" || strings.EqualFold(e.Name, ref) || strings.EqualFold(e.Slug, ref) {
+			return e.UUID, nil
+		}
+		names[i] = e.Name
+	}
+	return "", fmt.Errorf("no deployment environment %q; environments: %s", ref, strings.Join(names, ", "))
+}
+
+// findPipelineVariable returns the variable with key, or nil.
+func findPipelineVariable(vars []bitbucket.PipelineVariableEntry, key string) *bitbucket.PipelineVariableEntry {
+	for i := range vars {
+		if vars[i].Key == key {
+			return &vars[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var bbPipelineVariableDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete a repository or deployment variable",
+	RunE:  runBBPipelineVariableDelete,
+}
+
+func init() {
+	bbPipelineVariableDeleteCmd.Flags().String("workspace", "", "Workspace slug")
+	bbPipelineVariableDeleteCmd.Flags().String("repo", "", "Repository slug (required)")
+	bbPipelineVariableDeleteCmd.MarkFlagRequired("repo")
+	bbPipelineVariableDeleteCmd.Flags().String("environment", "", "Deployment environment name, slug or UUID")
+	bbPipelineVariableDeleteCmd.Flags().String("key", "", "Variable name (required)")
+	bbPipelineVariableDeleteCmd.MarkFlagRequired("key")
+	bbPipelineVariableDeleteCmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+	bbPipelineVariableCmd.AddCommand(bbPipelineVariableDeleteCmd)
+}
+
+func runBBPipelineVariableDelete(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+	environment, _ := cmd.Flags().GetString("environment")
+	key, _ := cmd.Flags().GetString("key")
+
+	envUUID, err := resolveEnvironmentUUID(client, workspace, repo, environment)
+	if err != nil {
+		return err
+	}
+	vars, err := client.ListPipelineVariables(workspace, repo, envUUID)
+	if err != nil {
+		return err
+	}
+	v := findPipelineVariable(vars, key)
+	if v == nil {
+		return fmt.Errorf("no variable %q", key)
+	}
+
+	if err := confirmDestructive(cmd, fmt.Sprintf("Delete variable %s?", key)); err != nil {
+		return err
+	}
+	if err := client.DeletePipelineVariable(workspace, repo, envUUID, v.UUID); err != nil {
+		return err
+	}
+
+	if jsonMode(cmd) {
+		return printJSON(JSONMutationResult{Key: key})
+	}
+
+	fmt.Printf("Deleted variable %s\n", key)
+	return nil
+}
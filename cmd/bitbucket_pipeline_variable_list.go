@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var bbPipelineVariableListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List repository or deployment variables",
+	RunE:  runBBPipelineVariableList,
+}
+
+func init() {
+	bbPipelineVariableListCmd.Flags().String("workspace", "", "Workspace slug")
+	bbPipelineVariableListCmd.Flags().String("repo", "", "Repository slug (required)")
+	bbPipelineVariableListCmd.MarkFlagRequired("repo")
+	bbPipelineVariableListCmd.Flags().String("environment", "", "Deployment environment name, slug or UUID")
+	bbPipelineVariableCmd.AddCommand(bbPipelineVariableListCmd)
+}
+
+func runBBPipelineVariableList(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+	environment, _ := cmd.Flags().GetString("environment")
+
+	envUUID, err := resolveEnvironmentUUID(client, workspace, repo, environment)
+	if err != nil {
+		return err
+	}
+	vars, err := client.ListPipelineVariables(workspace, repo, envUUID)
+	if err != nil {
+		return err
+	}
+
+	if jsonMode(cmd) {
+		items := make([]JSONPipelineVariable, len(vars))
+		for i, v := range vars {
+			items[i] = JSONPipelineVariable{UUID: v.UUID, Key: v.Key, Value: v.Value, Secured: v.Secured}
+		}
+		return printJSON(items)
+	}
+
+	if len(vars) == 0 {
+		fmt.Println("No variables found.")
+		return nil
+	}
+
+	fmt.Printf("Found %d variable(s):\n\n", len(vars))
+	for _, v := range vars {
+		value := v.Value
+		if v.Secured {
+			value = "******** (secured)"
+		}
+		fmt.Printf("%-30s  %s\n", v.Key, value)
+	}
+	return nil
+}
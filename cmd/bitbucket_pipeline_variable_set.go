@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/novshi-tech/atl-cli/internal/bitbucket"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+var bbPipelineVariableSetCmd = &cobra.Command{
+	Use:   "set",
+	Short: "Create or update a repository or deployment variable",
+	Long: "Create a variable, or update it if one with the same key exists. If " +
+		"--value is omitted the value is read from stdin (prompted for without " +
+		"echo on a terminal), which keeps secrets out of shell history.",
+	RunE: runBBPipelineVariableSet,
+}
+
+func init() {
+	bbPipelineVariableSetCmd.Flags().String("workspace", "", "Workspace slug")
+	bbPipelineVariableSetCmd.Flags().String("repo", "", "Repository slug (required)")
+	bbPipelineVariableSetCmd.MarkFlagRequired("repo")
+	bbPipelineVariableSetCmd.Flags().String("environment", "", "Deployment environment name, slug or UUID")
+	bbPipelineVariableSetCmd.Flags().String("key", "", "Variable name (required)")
+	bbPipelineVariableSetCmd.MarkFlagRequired("key")
+	bbPipelineVariableSetCmd.Flags().String("value", "", "Variable value (default: read from stdin)")
+	bbPipelineVariableSetCmd.Flags().Bool("secured", false, "Store the variable as secured (write-only); an existing variable keeps its setting unless given")
+	bbPipelineVariableCmd.AddCommand(bbPipelineVariableSetCmd)
+}
+
+func runBBPipelineVariableSet(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+	environment, _ := cmd.Flags().GetString("environment")
+	key, _ := cmd.Flags().GetString("key")
+	value, _ := cmd.Flags().GetString("value")
+	secured, _ := cmd.Flags().GetBool("secured")
+
+	if !cmd.Flags().Changed("value") {
+		value, err = readVariableValue(key)
+		if err != nil {
+			return err
+		}
+	}
+
+	envUUID, err := resolveEnvironmentUUID(client, workspace, repo, environment)
+	if err != nil {
+		return err
+	}
+	vars, err := client.ListPipelineVariables(workspace, repo, envUUID)
+	if err != nil {
+		return err
+	}
+
+	v := bitbucket.PipelineVariableEntry{Key: key, Value: value, Secured: secured}
+	verb := "Created"
+	var saved *bitbucket.PipelineVariableEntry
+	if existing := findPipelineVariable(vars, key); existing != nil {
+		v.UUID = existing.UUID
+		if !cmd.Flags().Changed("secured") {
+			v.Secured = existing.Secured
+		}
+		verb = "Updated"
+		saved, err = client.UpdatePipelineVariable(workspace, repo, envUUID, v)
+	} else {
+		saved, err = client.CreatePipelineVariable(workspace, repo, envUUID, v)
+	}
+	if err != nil {
+		return err
+	}
+
+	if jsonMode(cmd) {
+		return printJSON(JSONPipelineVariable{UUID: saved.UUID, Key: saved.Key, Value: saved.Value, Secured: saved.Secured})
+	}
+
+	fmt.Printf("%s variable %s\n", verb, key)
+	return nil
+}
+
+// readVariableValue reads a variable value from stdin: with a no-echo
+// prompt on a terminal, otherwise everything up to EOF minus a trailing
+// newline.
+func readVariableValue(key string) (string, error) {
+	if term.IsTerminal(int(os.Stdin.Fd())) {
+		fmt.Fprintf(os.Stderr, "Value for %s: ", key)
+		return readSecretDetectPaste()
+	}
+	data, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		return "", fmt.Errorf("reading stdin: %w", err)
+	}
+	return strings.TrimSuffix(strings.TrimSuffix(string(data), "\n"), "\r"), nil
+}
@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var bbPipelineViewCmd = &cobra.Command{
+	Use:   "view",
+	Short: "Show a pipeline and the state of its steps",
+	RunE:  runBBPipelineView,
+}
+
+func init() {
+	bbPipelineViewCmd.Flags().String("workspace", "", "Workspace slug")
+	bbPipelineViewCmd.Flags().String("repo", "", "Repository slug (required)")
+	bbPipelineViewCmd.MarkFlagRequired("repo")
+	bbPipelineViewCmd.Flags().String("id", "", "Pipeline build number or UUID (required)")
+	bbPipelineViewCmd.MarkFlagRequired("id")
+	bbPipelineViewCmd.Flags().Bool("wait", false, "Wait for the pipeline to finish; exit non-zero if it fails")
+	bbPipelineCmd.AddCommand(bbPipelineViewCmd)
+}
+
+func runBBPipelineView(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+	id, _ := cmd.Flags().GetString("id")
+	wait, _ := cmd.Flags().GetBool("wait")
+
+	p, err := client.GetPipeline(workspace, repo, id)
+	if err != nil {
+		return err
+	}
+	if wait && !p.State.Done() {
+		p, err = waitForPipeline(client, workspace, repo, p.UUID)
+		if err != nil {
+			return err
+		}
+	}
+
+	steps, err := client.ListPipelineSteps(workspace, repo, p.UUID)
+	if err != nil {
+		return err
+	}
+
+	detail := JSONPipelineDetail{
+		JSONPipelineItem: toJSONPipelineItem(workspace, repo, *p),
+		Steps:            make([]JSONPipelineStep, len(steps)),
+	}
+	for i, s := range steps {
+		detail.Steps[i] = JSONPipelineStep{
+			UUID:            s.UUID,
+			Name:            s.Name,
+			State:           s.State.Name,
+			Status:          s.State.Status(),
+			StartedOn:       s.StartedOn,
+			CompletedOn:     s.CompletedOn,
+			DurationSeconds: s.DurationInSeconds,
+		}
+	}
+
+	if jsonMode(cmd) {
+		if err := printJSON(detail); err != nil {
+			return err
+		}
+	} else {
+		fmt.Printf("Pipeline #%d: %s\n\n", detail.BuildNumber, detail.Status)
+		fmt.Printf("Target:    %s\n", pipelineTargetDesc(detail.JSONPipelineItem))
+		fmt.Printf("Trigger:   %s\n", detail.Trigger)
+		if detail.Creator != "" {
+			fmt.Printf("Creator:   %s\n", detail.Creator)
+		}
+		fmt.Printf("Created:   %s\n", detail.CreatedOn)
+		if detail.CompletedOn != "" {
+			fmt.Printf("Completed: %s\n", detail.CompletedOn)
+		}
+		fmt.Printf("Duration:  %s\n", formatSeconds(detail.DurationSeconds))
+
+		fmt.Println("\nSteps:")
+		if len(detail.Steps) == 0 {
+			fmt.Println("  (none)")
+		}
+		for _, s := range detail.Steps {
+			fmt.Printf("  %-11s  %-8s  %s\n", s.Status, formatSeconds(s.DurationSeconds), s.Name)
+		}
+		fmt.Printf("\nURL: %s\n", detail.URL)
+	}
+
+	if wait {
+		return pipelineResultError(cmd, p)
+	}
+	return nil
+}
@@ -1,6 +1,11 @@
 package cmd
 
-import "github.com/spf13/cobra"
+import (
+	"fmt"
+
+	"github.com/novshi-tech/atl-cli/internal/bitbucket"
+	"github.com/spf13/cobra"
+)
 
 var bbPRCmd = &cobra.Command{
 	Use:   "pr",
@@ -10,3 +15,31 @@ var bbPRCmd = &cobra.Command{
 func init() {
 	bitbucketCmd.AddCommand(bbPRCmd)
 }
+
+// bbPRURL returns the web URL of a pull request.
+func bbPRURL(workspace, repo string, prID int) string {
+	return fmt.Sprintf("https://bitbucket.org/%s/%s/pull-requests/%d", workspace, repo, prID)
+}
+
+// resolveBBReviewers resolves reviewer references (nickname, display name,
+// account ID or UUID) to reviewer refs, dropping duplicates.
+func resolveBBReviewers(client *bitbucket.Client, workspace string, refs []string) ([]bitbucket.PRReviewerRef, error) {
+	var reviewers []bitbucket.PRReviewerRef
+	for _, ref := range refs {
+		u, err := client.ResolveUser(workspace, ref)
+		if err != nil {
+			return nil, err
+		}
+		reviewers = appendReviewer(reviewers, u.UUID)
+	}
+	return reviewers, nil
+}
+
+func appendReviewer(reviewers []bitbucket.PRReviewerRef, uuid string) []bitbucket.PRReviewerRef {
+	for _, r := range reviewers {
+		if r.UUID == uuid {
+			return reviewers
+		}
+	}
+	return append(reviewers, bitbucket.PRReviewerRef{UUID: uuid})
+}
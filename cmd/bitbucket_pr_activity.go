@@ -0,0 +1,247 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/novshi-tech/atl-cli/internal/bitbucket"
+	"github.com/spf13/cobra"
+)
+
+var bbPRActivityCmd = &cobra.Command{
+	Use:   "activity",
+	Short: "Show the activity feed of a pull request",
+	Long: "Show approvals, change requests, updates (e.g. new commits pushed) and " +
+		"comments on a pull request, oldest first. --since limits the output to " +
+		"activity after a timestamp or after a given comment. --follow keeps " +
+		"polling and streams each new event as a line of JSON (NDJSON) until " +
+		"interrupted.",
+	RunE: runBBPRActivity,
+}
+
+func init() {
+	bbPRActivityCmd.Flags().String("workspace", "", "Workspace slug")
+	bbPRActivityCmd.Flags().String("repo", "", "Repository slug (required)")
+	bbPRActivityCmd.MarkFlagRequired("repo")
+	bbPRActivityCmd.Flags().Int("pr", 0, "Pull request ID (required)")
+	bbPRActivityCmd.MarkFlagRequired("pr")
+	bbPRActivityCmd.Flags().String("since", "", "Only show activity after this RFC 3339 timestamp, date (YYYY-MM-DD) or comment ID")
+	bbPRActivityCmd.Flags().Bool("follow", false, "Keep polling and stream new events as NDJSON")
+	bbPRActivityCmd.Flags().Duration("interval", 30*time.Second, "Polling interval for --follow")
+	bbPRCmd.AddCommand(bbPRActivityCmd)
+}
+
+func runBBPRActivity(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+	prID, _ := cmd.Flags().GetInt("pr")
+	sinceArg, _ := cmd.Flags().GetString("since")
+	follow, _ := cmd.Flags().GetBool("follow")
+	interval, _ := cmd.Flags().GetDuration("interval")
+
+	var since time.Time
+	if sinceArg != "" {
+		since, err = parseActivitySince(client, workspace, repo, prID, sinceArg)
+		if err != nil {
+			return err
+		}
+	}
+
+	activity, err := client.ListPRActivity(workspace, repo, prID, since)
+	if err != nil {
+		return err
+	}
+	events := toActivityEvents(activity)
+
+	if follow {
+		return followPRActivity(client, workspace, repo, prID, events, since, interval)
+	}
+
+	if jsonMode(cmd) {
+		return printJSON(events)
+	}
+
+	if len(events) == 0 {
+		fmt.Println("No activity found.")
+		return nil
+	}
+
+	fmt.Printf("Found %d event(s):\n\n", len(events))
+	for _, e := range events {
+		fmt.Println(formatActivityEvent(e))
+	}
+	return nil
+}
+
+// parseActivitySince interprets --since: an all-digit value is a comment
+// ID (meaning "after that comment was posted"), anything else a timestamp.
+func parseActivitySince(client *bitbucket.Client, workspace, repo string, prID int, s string) (time.Time, error) {
+	if id, err := strconv.Atoi(s); err == nil {
+		c, err := client.GetPRComment(workspace, repo, prID, id)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("looking up --since comment #%d: %w", id, err)
+		}
+		return time.Parse(time.RFC3339Nano, c.CreatedOn)
+	}
+	if t, ok := parseTimestamp(s); ok {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("invalid --since %q; use an RFC 3339 timestamp, YYYY-MM-DD or a comment ID", s)
+}
+
+// parseTimestamp parses an RFC 3339 timestamp or a local YYYY-MM-DD date.
+func parseTimestamp(s string) (time.Time, bool) {
+	if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+		return t, true
+	}
+	if t, err := time.ParseInLocation("2006-01-02", s, time.Local); err == nil {
+		return t, true
+	}
+	return time.Time{}, false
+}
+
+// followPRActivity streams events as NDJSON, starting with those already
+// fetched, then polling for new ones. Events with the same timestamp as the
+// newest seen are de-duplicated by their content; keys of older events are
+// forgotten, as polling never returns them again.
+func followPRActivity(client *bitbucket.Client, workspace, repo string, prID int, events []JSONPRActivityEvent, since time.Time, interval time.Duration) error {
+	enc := json.NewEncoder(os.Stdout)
+	seen := make(map[string]time.Time)
+	emit := func(events []JSONPRActivityEvent) error {
+		for _, e := range events {
+			key := activityEventKey(e)
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			t, err := time.Parse(time.RFC3339Nano, e.Date)
+			seen[key] = t
+			if err == nil && t.After(since) {
+				since = t
+			}
+			if err := enc.Encode(e); err != nil {
+				return err
+			}
+		}
+		// Events without a parseable date are always re-fetched, so their
+		// keys (with a zero time) are kept.
+		for key, t := range seen {
+			if !t.IsZero() && t.Before(since) {
+				delete(seen, key)
+			}
+		}
+		return nil
+	}
+
+	if err := emit(events); err != nil {
+		return err
+	}
+	for {
+		time.Sleep(interval)
+		// Re-fetch from just before the newest event so that events sharing
+		// its timestamp aren't missed; seen filters the repeats.
+		activity, err := client.ListPRActivity(workspace, repo, prID, since.Add(-time.Nanosecond))
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "warning: polling activity: %v\n", err)
+			continue
+		}
+		if err := emit(toActivityEvents(activity)); err != nil {
+			return err
+		}
+	}
+}
+
+func activityEventKey(e JSONPRActivityEvent) string {
+	return strings.Join([]string{e.Type, e.Date, e.User, strconv.Itoa(e.CommentID), e.Commit, e.State}, "\x00")
+}
+
+// toActivityEvents flattens activity entries into events, oldest first.
+func toActivityEvents(activity []bitbucket.PRActivity) []JSONPRActivityEvent {
+	events := make([]JSONPRActivityEvent, 0, len(activity))
+	for _, a := range activity {
+		e := JSONPRActivityEvent{Date: a.Date()}
+		switch {
+		case a.Comment != nil:
+			e.Type = "comment"
+			e.User = a.Comment.User.DisplayName
+			e.CommentID = a.Comment.ID
+			e.Body = a.Comment.Content.Raw
+			if a.Comment.Parent != nil {
+				e.ParentID = a.Comment.Parent.ID
+			}
+			if a.Comment.Inline != nil {
+				e.Path = a.Comment.Inline.Path
+				e.Line = a.Comment.Inline.To
+				if e.Line == nil {
+					e.Line = a.Comment.Inline.From
+				}
+			}
+		case a.Approval != nil:
+			e.Type = "approval"
+			e.User = a.Approval.User.DisplayName
+		case a.ChangesRequested != nil:
+			e.Type = "changes_requested"
+			e.User = a.ChangesRequested.User.DisplayName
+		case a.Update != nil:
+			e.Type = "update"
+			e.User = a.Update.Author.DisplayName
+			e.State = a.Update.State
+			if a.Update.Source.Commit != nil {
+				e.Commit = a.Update.Source.Commit.Hash
+			}
+		default:
+			continue
+		}
+		events = append(events, e)
+	}
+	slices.Reverse(events)
+	slices.SortStableFunc(events, func(a, b JSONPRActivityEvent) int {
+		return strings.Compare(normalizeBBTime(a.Date), normalizeBBTime(b.Date))
+	})
+	return events
+}
+
+// normalizeBBTime returns t in UTC with a fixed layout so that timestamps
+// compare correctly as strings; unparseable values are returned as is.
+func normalizeBBTime(s string) string {
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return s
+	}
+	return t.UTC().Format("2006-01-02T15:04:05.000000000")
+}
+
+func formatActivityEvent(e JSONPRActivityEvent) string {
+	switch e.Type {
+	case "comment":
+		where := ""
+		if e.Path != "" {
+			where = " on " + e.Path
+			if e.Line != nil {
+				where += fmt.Sprintf(" (line %d)", *e.Line)
+			}
+		}
+		return fmt.Sprintf("[%s] %s commented #%d%s%s:\n%s\n", e.Date, e.User, e.CommentID, replyRef(e.ParentID), where, e.Body)
+	case "approval":
+		return fmt.Sprintf("[%s] %s approved", e.Date, e.User)
+	case "changes_requested":
+		return fmt.Sprintf("[%s] %s requested changes", e.Date, e.User)
+	}
+	commit := e.Commit
+	if len(commit) > 12 {
+		commit = commit[:12]
+	}
+	return fmt.Sprintf("[%s] %s updated the pull request (%s, source %s)", e.Date, e.User, e.State, commit)
+}
@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var bbPRApproveCmd = &cobra.Command{
+	Use:   "approve",
+	Short: "Approve a pull request",
+	RunE:  runBBPRApprove,
+}
+
+func init() {
+	bbPRApproveCmd.Flags().String("workspace", "", "Workspace slug")
+	bbPRApproveCmd.Flags().String("repo", "", "Repository slug (required)")
+	bbPRApproveCmd.MarkFlagRequired("repo")
+	bbPRApproveCmd.Flags().Int("pr", 0, "Pull request ID (required)")
+	bbPRApproveCmd.MarkFlagRequired("pr")
+	bbPRCmd.AddCommand(bbPRApproveCmd)
+}
+
+func runBBPRApprove(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+	prID, _ := cmd.Flags().GetInt("pr")
+
+	if err := client.ApprovePullRequest(workspace, repo, prID); err != nil {
+		return err
+	}
+
+	prURL := bbPRURL(workspace, repo, prID)
+
+	if jsonMode(cmd) {
+		return printJSON(JSONMutationResult{
+			Key: fmt.Sprintf("%d", prID),
+			URL: prURL,
+		})
+	}
+
+	fmt.Printf("Approved pull request #%d\n", prID)
+	fmt.Printf("URL: %s\n", prURL)
+	return nil
+}
@@ -1,8 +1,12 @@
 package cmd
 
 import (
+	"cmp"
 	"fmt"
+	"slices"
+	"strings"
 
+	"github.com/novshi-tech/atl-cli/internal/bitbucket"
 	"github.com/spf13/cobra"
 )
 
@@ -20,6 +24,7 @@ func init() {
 	bbPRCommentCmd.MarkFlagRequired("pr")
 	bbPRCommentCmd.Flags().Bool("inline", true, "Include inline code review comments")
 	bbPRCommentCmd.Flags().Bool("include-resolved", false, "Include resolved comments (excluded by default)")
+	bbPRCommentCmd.Flags().Bool("threaded", false, "Nest replies under their root comment and group inline threads by file and line")
 	bbPRCmd.AddCommand(bbPRCommentCmd)
 }
 
@@ -37,12 +42,17 @@ func runBBPRComment(cmd *cobra.Command, args []string) error {
 	prID, _ := cmd.Flags().GetInt("pr")
 	showInline, _ := cmd.Flags().GetBool("inline")
 	includeResolved, _ := cmd.Flags().GetBool("include-resolved")
+	threaded, _ := cmd.Flags().GetBool("threaded")
 
 	resp, err := client.ListPRComments(workspace, repo, prID)
 	if err != nil {
 		return err
 	}
 
+	if threaded {
+		return printPRCommentThreads(cmd, resp.Values, showInline, includeResolved)
+	}
+
 	var comments []JSONCommentItem
 	var inlineComments []JSONInlineCommentItem
 	for _, c := range resp.Values {
@@ -126,3 +136,144 @@ func prInlineLineRef(from, to *int) string {
 	}
 	return ""
 }
+
+// buildPRCommentThreads nests comments under their parents and returns the
+// root comments in their original order, general comments first and then
+// inline comments sorted by file and line. Replies whose parent isn't in
+// comments are treated as roots.
+func buildPRCommentThreads(comments []bitbucket.PRComment) (general, inline []JSONCommentThread) {
+	byID := make(map[int]bitbucket.PRComment, len(comments))
+	children := make(map[int][]int)
+	for _, c := range comments {
+		byID[c.ID] = c
+	}
+	var roots []int
+	for _, c := range comments {
+		if c.Parent != nil {
+			if _, ok := byID[c.Parent.ID]; ok {
+				children[c.Parent.ID] = append(children[c.Parent.ID], c.ID)
+				continue
+			}
+		}
+		roots = append(roots, c.ID)
+	}
+
+	var build func(id int) JSONCommentThread
+	build = func(id int) JSONCommentThread {
+		c := byID[id]
+		t := JSONCommentThread{
+			ID:      c.ID,
+			Author:  c.User.DisplayName,
+			Created: c.CreatedOn,
+			Body:    c.Content.Raw,
+		}
+		if c.Inline != nil {
+			t.Path, t.From, t.To = c.Inline.Path, c.Inline.From, c.Inline.To
+		}
+		if c.Resolution != nil {
+			t.Resolved = true
+			t.ResolvedBy = c.Resolution.User.DisplayName
+		}
+		for _, child := range children[id] {
+			t.Replies = append(t.Replies, build(child))
+		}
+		return t
+	}
+
+	for _, id := range roots {
+		t := build(id)
+		if byID[id].Inline != nil {
+			inline = append(inline, t)
+		} else {
+			general = append(general, t)
+		}
+	}
+	slices.SortStableFunc(inline, func(a, b JSONCommentThread) int {
+		return cmp.Or(strings.Compare(a.Path, b.Path), cmp.Compare(threadLine(a), threadLine(b)))
+	})
+	return general, inline
+}
+
+// threadLine is the line an inline thread is anchored to, for sorting.
+func threadLine(t JSONCommentThread) int {
+	if t.To != nil {
+		return *t.To
+	}
+	if t.From != nil {
+		return *t.From
+	}
+	return 0
+}
+
+func printPRCommentThreads(cmd *cobra.Command, comments []bitbucket.PRComment, showInline, includeResolved bool) error {
+	general, inline := buildPRCommentThreads(comments)
+	if !includeResolved {
+		resolved := func(t JSONCommentThread) bool { return t.Resolved }
+		general = slices.DeleteFunc(general, resolved)
+		inline = slices.DeleteFunc(inline, resolved)
+	}
+	if !showInline {
+		inline = nil
+	}
+
+	if jsonMode(cmd) {
+		if showInline {
+			return printJSON(struct {
+				Comments       []JSONCommentThread `json:"comments"`
+				InlineComments []JSONCommentThread `json:"inline_comments"`
+			}{general, inline})
+		}
+		return printJSON(general)
+	}
+
+	if len(general) == 0 && len(inline) == 0 {
+		fmt.Println("No comments found.")
+		return nil
+	}
+
+	if len(general) > 0 {
+		fmt.Printf("Found %d thread(s):\n\n", len(general))
+		for _, t := range general {
+			fmt.Printf("%s\n", threadState(t))
+			printCommentTree(t, "  ")
+			fmt.Println()
+		}
+	}
+
+	if len(inline) > 0 {
+		fmt.Printf("Found %d inline thread(s):\n\n", len(inline))
+		path := ""
+		for _, t := range inline {
+			if t.Path != path {
+				path = t.Path
+				fmt.Printf("== %s ==\n\n", path)
+			}
+			fmt.Println(strings.TrimSpace(prInlineLineRef(t.From, t.To) + " " + threadState(t)))
+			printCommentTree(t, "  ")
+			fmt.Println()
+		}
+	}
+	return nil
+}
+
+func threadState(t JSONCommentThread) string {
+	if t.Resolved {
+		if t.ResolvedBy != "" {
+			return "[resolved by " + t.ResolvedBy + "]"
+		}
+		return "[resolved]"
+	}
+	return "[open]"
+}
+
+// printCommentTree prints a comment and its replies, indenting each level
+// of replies by two more spaces.
+func printCommentTree(t JSONCommentThread, indent string) {
+	fmt.Printf("%s[#%d][%s] %s:\n", indent, t.ID, t.Created, t.Author)
+	for _, line := range strings.Split(t.Body, "\n") {
+		fmt.Printf("%s%s\n", indent, line)
+	}
+	for _, r := range t.Replies {
+		printCommentTree(r, indent+"  ")
+	}
+}
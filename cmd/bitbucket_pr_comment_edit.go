@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var bbPRCommentEditCmd = &cobra.Command{
+	Use:   "edit",
+	Short: "Edit a comment on a pull request",
+	RunE:  runBBPRCommentEdit,
+}
+
+func init() {
+	bbPRCommentEditCmd.Flags().String("workspace", "", "Workspace slug")
+	bbPRCommentEditCmd.Flags().String("repo", "", "Repository slug (required)")
+	bbPRCommentEditCmd.MarkFlagRequired("repo")
+	bbPRCommentEditCmd.Flags().Int("pr", 0, "Pull request ID (required)")
+	bbPRCommentEditCmd.MarkFlagRequired("pr")
+	bbPRCommentEditCmd.Flags().Int("id", 0, "Comment ID (required)")
+	bbPRCommentEditCmd.MarkFlagRequired("id")
+	bbPRCommentEditCmd.Flags().StringP("body", "b", "", "New comment body (required)")
+	bbPRCommentEditCmd.MarkFlagRequired("body")
+	bbPRCommentCmd.AddCommand(bbPRCommentEditCmd)
+}
+
+func runBBPRCommentEdit(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+	prID, _ := cmd.Flags().GetInt("pr")
+	commentID, _ := cmd.Flags().GetInt("id")
+	body, _ := cmd.Flags().GetString("body")
+
+	comment, err := client.UpdatePRComment(workspace, repo, prID, commentID, body)
+	if err != nil {
+		return err
+	}
+
+	commentURL := bbPRURL(workspace, repo, prID)
+	if comment.Links != nil && comment.Links.HTML.Href != "" {
+		commentURL = comment.Links.HTML.Href
+	}
+
+	if jsonMode(cmd) {
+		return printJSON(JSONMutationResult{
+			Key: fmt.Sprintf("%d", commentID),
+			URL: commentURL,
+		})
+	}
+
+	fmt.Printf("Comment #%d updated on pull request #%d\n", commentID, prID)
+	fmt.Printf("URL: %s\n", commentURL)
+	return nil
+}
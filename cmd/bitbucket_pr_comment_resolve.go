@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var bbPRCommentResolveCmd = &cobra.Command{
+	Use:   "resolve",
+	Short: "Resolve a comment thread on a pull request",
+	RunE:  runBBPRCommentResolve,
+}
+
+var bbPRCommentReopenCmd = &cobra.Command{
+	Use:   "reopen",
+	Short: "Reopen a resolved comment thread on a pull request",
+	RunE:  runBBPRCommentResolve,
+}
+
+func init() {
+	for _, c := range []*cobra.Command{bbPRCommentResolveCmd, bbPRCommentReopenCmd} {
+		c.Flags().String("workspace", "", "Workspace slug")
+		c.Flags().String("repo", "", "Repository slug (required)")
+		c.MarkFlagRequired("repo")
+		c.Flags().Int("pr", 0, "Pull request ID (required)")
+		c.MarkFlagRequired("pr")
+		c.Flags().Int("id", 0, "Comment ID of the thread's root comment (required)")
+		c.MarkFlagRequired("id")
+		bbPRCommentCmd.AddCommand(c)
+	}
+}
+
+// runBBPRCommentResolve backs both resolve and reopen, which differ only in
+// the HTTP method used on the /resolve endpoint.
+func runBBPRCommentResolve(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+	prID, _ := cmd.Flags().GetInt("pr")
+	commentID, _ := cmd.Flags().GetInt("id")
+
+	verb := "resolved"
+	if cmd.Name() == "reopen" {
+		verb = "reopened"
+		err = client.ReopenPRComment(workspace, repo, prID, commentID)
+	} else {
+		err = client.ResolvePRComment(workspace, repo, prID, commentID)
+	}
+	if err != nil {
+		return err
+	}
+
+	prURL := bbPRURL(workspace, repo, prID)
+
+	if jsonMode(cmd) {
+		return printJSON(JSONMutationResult{
+			Key: fmt.Sprintf("%d", commentID),
+			URL: prURL,
+		})
+	}
+
+	fmt.Printf("Comment #%d %s on pull request #%d\n", commentID, verb, prID)
+	fmt.Printf("URL: %s\n", prURL)
+	return nil
+}
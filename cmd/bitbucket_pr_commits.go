@@ -0,0 +1,38 @@
+package cmd
+
+import "github.com/spf13/cobra"
+
+var bbPRCommitsCmd = &cobra.Command{
+	Use:   "commits",
+	Short: "List the commits of a pull request",
+	RunE:  runBBPRCommits,
+}
+
+func init() {
+	bbPRCommitsCmd.Flags().String("workspace", "", "Workspace slug")
+	bbPRCommitsCmd.Flags().String("repo", "", "Repository slug (required)")
+	bbPRCommitsCmd.MarkFlagRequired("repo")
+	bbPRCommitsCmd.Flags().Int("pr", 0, "Pull request ID (required)")
+	bbPRCommitsCmd.MarkFlagRequired("pr")
+	bbPRCmd.AddCommand(bbPRCommitsCmd)
+}
+
+func runBBPRCommits(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+	prID, _ := cmd.Flags().GetInt("pr")
+
+	commits, err := client.ListPRCommits(workspace, repo, prID)
+	if err != nil {
+		return err
+	}
+	return printCommitList(cmd, workspace, repo, commits)
+}
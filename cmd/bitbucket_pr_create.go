@@ -23,6 +23,10 @@ func init() {
 	bbPRCreateCmd.MarkFlagRequired("source")
 	bbPRCreateCmd.Flags().String("dest", "", "Destination branch (defaults to repo main branch)")
 	bbPRCreateCmd.Flags().StringP("description", "d", "", "Pull request description")
+	bbPRCreateCmd.Flags().StringSlice("reviewer", nil, "Reviewer: nickname, display name, account ID or UUID (repeatable)")
+	bbPRCreateCmd.Flags().Bool("default-reviewers", false, "Add the repository's default reviewers")
+	bbPRCreateCmd.Flags().Bool("close-source-branch", false, "Delete the source branch when the pull request is merged")
+	bbPRCreateCmd.Flags().Bool("draft", false, "Create the pull request as a draft")
 	bbPRCmd.AddCommand(bbPRCreateCmd)
 }
 
@@ -41,6 +45,10 @@ func runBBPRCreate(cmd *cobra.Command, args []string) error {
 	source, _ := cmd.Flags().GetString("source")
 	dest, _ := cmd.Flags().GetString("dest")
 	description, _ := cmd.Flags().GetString("description")
+	reviewerRefs, _ := cmd.Flags().GetStringSlice("reviewer")
+	defaultReviewers, _ := cmd.Flags().GetBool("default-reviewers")
+	closeSource, _ := cmd.Flags().GetBool("close-source-branch")
+	draft, _ := cmd.Flags().GetBool("draft")
 
 	req := bitbucket.CreatePRRequest{
 		Title:  title,
@@ -52,6 +60,30 @@ func runBBPRCreate(cmd *cobra.Command, args []string) error {
 	if description != "" {
 		req.Description = description
 	}
+	req.CloseSourceBranch = closeSource
+	req.Draft = draft
+
+	req.Reviewers, err = resolveBBReviewers(client, workspace, reviewerRefs)
+	if err != nil {
+		return err
+	}
+	if defaultReviewers {
+		defaults, err := client.ListEffectiveDefaultReviewers(workspace, repo)
+		if err != nil {
+			return err
+		}
+		// The author can't review their own pull request, and Bitbucket
+		// rejects the request if they're listed.
+		me, err := client.GetCurrentUser()
+		if err != nil {
+			return err
+		}
+		for _, d := range defaults {
+			if d.User.UUID != me.UUID {
+				req.Reviewers = appendReviewer(req.Reviewers, d.User.UUID)
+			}
+		}
+	}
 
 	pr, err := client.CreatePullRequest(workspace, repo, req)
 	if err != nil {
@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var bbPRDeclineCmd = &cobra.Command{
+	Use:   "decline",
+	Short: "Decline a pull request",
+	RunE:  runBBPRDecline,
+}
+
+func init() {
+	bbPRDeclineCmd.Flags().String("workspace", "", "Workspace slug")
+	bbPRDeclineCmd.Flags().String("repo", "", "Repository slug (required)")
+	bbPRDeclineCmd.MarkFlagRequired("repo")
+	bbPRDeclineCmd.Flags().Int("pr", 0, "Pull request ID (required)")
+	bbPRDeclineCmd.MarkFlagRequired("pr")
+	bbPRCmd.AddCommand(bbPRDeclineCmd)
+}
+
+func runBBPRDecline(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+	prID, _ := cmd.Flags().GetInt("pr")
+
+	pr, err := client.DeclinePullRequest(workspace, repo, prID)
+	if err != nil {
+		return err
+	}
+
+	prURL := pr.Links.HTML.Href
+	if prURL == "" {
+		prURL = bbPRURL(workspace, repo, prID)
+	}
+
+	if jsonMode(cmd) {
+		return printJSON(JSONMutationResult{
+			Key: fmt.Sprintf("%d", prID),
+			URL: prURL,
+		})
+	}
+
+	fmt.Printf("Declined pull request #%d\n", prID)
+	fmt.Printf("URL: %s\n", prURL)
+	return nil
+}
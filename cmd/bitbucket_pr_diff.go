@@ -0,0 +1,198 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/novshi-tech/atl-cli/internal/bitbucket"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+var bbPRDiffCmd = &cobra.Command{
+	Use:   "diff",
+	Short: "Show the diff of a pull request",
+	Long: "Show the unified diff of a pull request, or with --stat / --name-only a " +
+		"summary of the changed files. --path limits the output to files matching " +
+		"a glob (matched against the full path, or the base name for patterns " +
+		"without a slash; a directory matches everything below it).",
+	RunE: runBBPRDiff,
+}
+
+func init() {
+	bbPRDiffCmd.Flags().String("workspace", "", "Workspace slug")
+	bbPRDiffCmd.Flags().String("repo", "", "Repository slug (required)")
+	bbPRDiffCmd.MarkFlagRequired("repo")
+	bbPRDiffCmd.Flags().Int("pr", 0, "Pull request ID (required)")
+	bbPRDiffCmd.MarkFlagRequired("pr")
+	bbPRDiffCmd.Flags().StringSlice("path", nil, "Only show files matching this glob (repeatable)")
+	bbPRDiffCmd.Flags().Bool("stat", false, "Show changed files with added/removed line counts")
+	bbPRDiffCmd.Flags().Bool("name-only", false, "Show only the names of changed files")
+	bbPRDiffCmd.MarkFlagsMutuallyExclusive("stat", "name-only")
+	bbPRDiffCmd.Flags().String("color", "auto", "Colorize the diff: auto, always, never")
+	bbPRCmd.AddCommand(bbPRDiffCmd)
+}
+
+func runBBPRDiff(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+	prID, _ := cmd.Flags().GetInt("pr")
+	patterns, _ := cmd.Flags().GetStringSlice("path")
+	stat, _ := cmd.Flags().GetBool("stat")
+	nameOnly, _ := cmd.Flags().GetBool("name-only")
+	colorMode, _ := cmd.Flags().GetString("color")
+
+	var color bool
+	switch colorMode {
+	case "auto":
+		color = term.IsTerminal(int(os.Stdout.Fd())) && os.Getenv("NO_COLOR") == ""
+	case "always":
+		color = true
+	case "never":
+	default:
+		return fmt.Errorf("invalid --color %q; must be auto, always or never", colorMode)
+	}
+
+	if stat || nameOnly {
+		stats, err := client.ListPRDiffStat(workspace, repo, prID)
+		if err != nil {
+			return err
+		}
+		var filtered []bitbucket.DiffStat
+		for _, d := range stats {
+			if matchAnyPath(patterns, d.Path()) || d.Old != nil && matchAnyPath(patterns, d.Old.Path) {
+				filtered = append(filtered, d)
+			}
+		}
+		return printDiffStat(cmd, filtered, nameOnly)
+	}
+
+	diff, err := client.GetPRDiff(workspace, repo, prID)
+	if err != nil {
+		return err
+	}
+	var files []bitbucket.FileDiff
+	for _, f := range bitbucket.SplitDiff(diff) {
+		if matchAnyPath(patterns, f.Path()) || f.OldPath != "" && matchAnyPath(patterns, f.OldPath) {
+			files = append(files, f)
+		}
+	}
+
+	if jsonMode(cmd) {
+		items := make([]JSONFileDiff, len(files))
+		for i, f := range files {
+			items[i] = JSONFileDiff{Path: f.Path(), Diff: f.Text}
+			if f.OldPath != f.Path() {
+				items[i].OldPath = f.OldPath
+			}
+		}
+		return printJSON(items)
+	}
+
+	for _, f := range files {
+		if color {
+			fmt.Print(colorizeDiff(f.Text))
+		} else {
+			fmt.Print(f.Text)
+		}
+	}
+	return nil
+}
+
+func printDiffStat(cmd *cobra.Command, stats []bitbucket.DiffStat, nameOnly bool) error {
+	if jsonMode(cmd) {
+		items := make([]JSONDiffStatItem, len(stats))
+		for i, d := range stats {
+			items[i] = JSONDiffStatItem{
+				Path:         d.Path(),
+				Status:       d.Status,
+				LinesAdded:   d.LinesAdded,
+				LinesRemoved: d.LinesRemoved,
+			}
+			if d.Old != nil && d.Old.Path != d.Path() {
+				items[i].OldPath = d.Old.Path
+			}
+		}
+		return printJSON(items)
+	}
+
+	if nameOnly {
+		for _, d := range stats {
+			fmt.Println(d.Path())
+		}
+		return nil
+	}
+
+	var added, removed int
+	for _, d := range stats {
+		name := d.Path()
+		if d.Old != nil && d.Old.Path != name {
+			name = d.Old.Path + " => " + name
+		}
+		fmt.Printf(" %-9s  +%-5d -%-5d  %s\n", d.Status, d.LinesAdded, d.LinesRemoved, name)
+		added += d.LinesAdded
+		removed += d.LinesRemoved
+	}
+	fmt.Printf(" %d file(s) changed, %d insertion(s)(+), %d deletion(s)(-)\n", len(stats), added, removed)
+	return nil
+}
+
+// matchAnyPath reports whether file matches one of patterns; no patterns
+// matches everything.
+func matchAnyPath(patterns []string, file string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, p := range patterns {
+		if bitbucket.MatchPath(p, file) {
+			return true
+		}
+	}
+	return false
+}
+
+const (
+	ansiReset = "\033[0m"
+	ansiBold  = "\033[1m"
+	ansiRed   = "\033[31m"
+	ansiGreen = "\033[32m"
+	ansiCyan  = "\033[36m"
+)
+
+// colorizeDiff adds git-style ANSI colors to a unified diff.
+func colorizeDiff(diff string) string {
+	var b strings.Builder
+	for _, line := range strings.SplitAfter(diff, "\n") {
+		if line == "" {
+			continue
+		}
+		text := strings.TrimRight(line, "\n")
+		color := ""
+		switch {
+		case strings.HasPrefix(text, "diff --git "), strings.HasPrefix(text, "index "),
+			strings.HasPrefix(text, "--- "), strings.HasPrefix(text, "+++ "):
+			color = ansiBold
+		case strings.HasPrefix(text, "@@"):
+			color = ansiCyan
+		case strings.HasPrefix(text, "+"):
+			color = ansiGreen
+		case strings.HasPrefix(text, "-"):
+			color = ansiRed
+		}
+		if color == "" {
+			b.WriteString(line)
+			continue
+		}
+		b.WriteString(color + text + ansiReset + line[len(text):])
+	}
+	return b.String()
+}
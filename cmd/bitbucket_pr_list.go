@@ -2,25 +2,49 @@ package cmd
 
 import (
 	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"sync"
 
+	"github.com/novshi-tech/atl-cli/internal/bitbucket"
 	"github.com/spf13/cobra"
 )
 
 var bbPRListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List pull requests in a repository",
-	RunE:  runBBPRList,
+	Long: "List pull requests in a repository, or with --workspace-wide across every " +
+		"repository in the workspace. --author and --reviewer take \"me\", a " +
+		"nickname, display name, account ID or UUID; --source and --dest accept " +
+		"glob patterns such as feature/*; --query adds a raw BBQL expression. " +
+		"With --workspace-wide, --reviewer lists the pull requests awaiting that " +
+		"reviewer: those they haven't approved or requested changes on. " +
+		"Repositories that can't be searched (e.g. pull requests disabled) are " +
+		"reported on stderr and skipped.",
+	RunE: runBBPRList,
 }
 
 func init() {
 	bbPRListCmd.Flags().String("workspace", "", "Workspace slug")
-	bbPRListCmd.Flags().String("repo", "", "Repository slug (required)")
-	bbPRListCmd.MarkFlagRequired("repo")
-	bbPRListCmd.Flags().String("state", "", "Filter by state: OPEN, MERGED, DECLINED, SUPERSEDED (default: OPEN)")
+	bbPRListCmd.Flags().String("repo", "", "Repository slug (required unless --workspace-wide)")
+	bbPRListCmd.Flags().Bool("workspace-wide", false, "Search every repository in the workspace")
+	bbPRListCmd.MarkFlagsMutuallyExclusive("repo", "workspace-wide")
+	bbPRListCmd.Flags().StringSlice("state", nil, "Filter by state(s): OPEN, MERGED, DECLINED, SUPERSEDED (default: OPEN)")
+	bbPRListCmd.Flags().String("author", "", "Filter by author")
+	bbPRListCmd.Flags().String("reviewer", "", "Filter by reviewer")
+	bbPRListCmd.Flags().String("source", "", "Filter by source branch (glob)")
+	bbPRListCmd.Flags().String("dest", "", "Filter by destination branch (glob)")
+	bbPRListCmd.Flags().String("query", "", "Additional BBQL filter, e.g. 'title ~ \"fix\"'")
 	bbPRListCmd.Flags().Int("max", 25, "Maximum number of results")
+	bbPRListCmd.Flags().Bool("all", false, "Return all results, ignoring --max")
 	bbPRCmd.AddCommand(bbPRListCmd)
 }
 
+// bbPRListConcurrency bounds the number of repositories queried at once by
+// --workspace-wide.
+const bbPRListConcurrency = 8
+
 func runBBPRList(cmd *cobra.Command, args []string) error {
 	client, err := newBitbucketClient(cmd)
 	if err != nil {
@@ -32,39 +56,162 @@ func runBBPRList(cmd *cobra.Command, args []string) error {
 		return err
 	}
 	repo, _ := cmd.Flags().GetString("repo")
-	state, _ := cmd.Flags().GetString("state")
+	workspaceWide, _ := cmd.Flags().GetBool("workspace-wide")
+	states, _ := cmd.Flags().GetStringSlice("state")
+	author, _ := cmd.Flags().GetString("author")
+	reviewer, _ := cmd.Flags().GetString("reviewer")
+	source, _ := cmd.Flags().GetString("source")
+	dest, _ := cmd.Flags().GetString("dest")
+	query, _ := cmd.Flags().GetString("query")
 	max, _ := cmd.Flags().GetInt("max")
+	all, _ := cmd.Flags().GetBool("all")
 
-	resp, err := client.ListPullRequests(workspace, repo, state, 1, max)
-	if err != nil {
-		return err
+	if repo == "" && !workspaceWide {
+		return fmt.Errorf("--repo is required (or use --workspace-wide)")
+	}
+	if all {
+		max = 0
+	}
+
+	filter := bitbucket.PRFilter{Source: source, Dest: dest, Query: query}
+	for _, s := range states {
+		s = strings.ToUpper(strings.TrimSpace(s))
+		if !slices.Contains(bitbucket.PRStates, s) {
+			return fmt.Errorf("invalid --state %q; must be one of: %s", s, strings.Join(bitbucket.PRStates, ", "))
+		}
+		filter.States = append(filter.States, s)
+	}
+	if author != "" {
+		if filter.AuthorUUID, err = resolveBBUserUUID(client, workspace, author); err != nil {
+			return err
+		}
+	}
+	if reviewer != "" {
+		if filter.ReviewerUUID, err = resolveBBUserUUID(client, workspace, reviewer); err != nil {
+			return err
+		}
+	}
+
+	type repoPR struct {
+		repo string
+		pr   bitbucket.PullRequest
+	}
+	var prs []repoPR
+	if workspaceWide {
+		repos, err := client.ListAllRepositories(workspace)
+		if err != nil {
+			return err
+		}
+		// Awaiting review is decided here rather than by the query, so
+		// each repository's results are fetched in full before filtering.
+		awaiting := filter.ReviewerUUID != ""
+		repoMax := max
+		if awaiting {
+			filter.WithParticipants = true
+			repoMax = 0
+		}
+		results := make([][]bitbucket.PullRequest, len(repos))
+		errs := make([]error, len(repos))
+		sem := make(chan struct{}, bbPRListConcurrency)
+		var wg sync.WaitGroup
+		for i, r := range repos {
+			wg.Add(1)
+			sem <- struct{}{}
+			go func() {
+				defer wg.Done()
+				defer func() { <-sem }()
+				results[i], errs[i] = client.ListPullRequests(workspace, r.Slug, filter, repoMax)
+			}()
+		}
+		wg.Wait()
+		for i, r := range repos {
+			if errs[i] != nil {
+				fmt.Fprintf(os.Stderr, "warning: skipping %s: %v\n", r.Slug, errs[i])
+				continue
+			}
+			for _, pr := range results[i] {
+				if awaiting && !awaitingReview(pr, filter.ReviewerUUID) {
+					continue
+				}
+				prs = append(prs, repoPR{r.Slug, pr})
+			}
+		}
+		slices.SortStableFunc(prs, func(a, b repoPR) int {
+			return strings.Compare(normalizeBBTime(b.pr.UpdatedOn), normalizeBBTime(a.pr.UpdatedOn))
+		})
+		if max > 0 && len(prs) > max {
+			prs = prs[:max]
+		}
+	} else {
+		values, err := client.ListPullRequests(workspace, repo, filter, max)
+		if err != nil {
+			return err
+		}
+		for _, pr := range values {
+			prs = append(prs, repoPR{repo, pr})
+		}
 	}
 
 	if jsonMode(cmd) {
-		items := make([]JSONPRItem, len(resp.Values))
-		for i, pr := range resp.Values {
+		items := make([]JSONPRItem, len(prs))
+		for i, p := range prs {
 			items[i] = JSONPRItem{
-				ID:     pr.ID,
-				Title:  pr.Title,
-				State:  pr.State,
-				Author: pr.Author.DisplayName,
-				Source: pr.Source.Branch.Name,
-				Dest:   pr.Destination.Branch.Name,
+				ID:     p.pr.ID,
+				Title:  p.pr.Title,
+				State:  p.pr.State,
+				Author: p.pr.Author.DisplayName,
+				Source: p.pr.Source.Branch.Name,
+				Dest:   p.pr.Destination.Branch.Name,
+			}
+			if workspaceWide {
+				items[i].Repo = p.repo
+				items[i].URL = p.pr.Links.HTML.Href
 			}
 		}
 		return printJSON(items)
 	}
 
-	if len(resp.Values) == 0 {
+	if len(prs) == 0 {
 		fmt.Println("No pull requests found.")
 		return nil
 	}
 
-	fmt.Printf("Found %d pull request(s):\n\n", len(resp.Values))
-	for _, pr := range resp.Values {
+	fmt.Printf("Found %d pull request(s):\n\n", len(prs))
+	for _, p := range prs {
+		if workspaceWide {
+			fmt.Printf("%-20s  ", p.repo)
+		}
 		fmt.Printf("#%-6d  %-10s  %-20s  %s→%s  %s\n",
-			pr.ID, pr.State, pr.Author.DisplayName,
-			pr.Source.Branch.Name, pr.Destination.Branch.Name, pr.Title)
+			p.pr.ID, p.pr.State, p.pr.Author.DisplayName,
+			p.pr.Source.Branch.Name, p.pr.Destination.Branch.Name, p.pr.Title)
 	}
 	return nil
 }
+
+// awaitingReview reports whether the reviewer with the given UUID has
+// neither approved pr nor requested changes on it.
+func awaitingReview(pr bitbucket.PullRequest, uuid string) bool {
+	for _, p := range pr.Participants {
+		if p.User.UUID == uuid && (p.Approved || p.State == "changes_requested") {
+			return false
+		}
+	}
+	return true
+}
+
+// resolveBBUserUUID resolves a user reference to a UUID; "me" is the
+// authenticated user.
+func resolveBBUserUUID(client *bitbucket.Client, workspace, ref string) (string, error) {
+	if strings.EqualFold(ref, "me") {
+		me, err := client.GetCurrentUser()
+		if err != nil {
+			return "", err
+		}
+		return me.UUID, nil
+	}
+	u, err := client.ResolveUser(workspace, ref)
+	if err != nil {
+		return "", err
+	}
+	return u.UUID, nil
+}
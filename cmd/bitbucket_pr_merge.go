@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/novshi-tech/atl-cli/internal/bitbucket"
+	"github.com/spf13/cobra"
+)
+
+var bbPRMergeCmd = &cobra.Command{
+	Use:   "merge",
+	Short: "Merge a pull request",
+	Long: "Merge a pull request. The merge runs asynchronously on Bitbucket; this " +
+		"command waits for it to finish (up to --timeout) and fails if it does not succeed.",
+	RunE: runBBPRMerge,
+}
+
+func init() {
+	bbPRMergeCmd.Flags().String("workspace", "", "Workspace slug")
+	bbPRMergeCmd.Flags().String("repo", "", "Repository slug (required)")
+	bbPRMergeCmd.MarkFlagRequired("repo")
+	bbPRMergeCmd.Flags().Int("pr", 0, "Pull request ID (required)")
+	bbPRMergeCmd.MarkFlagRequired("pr")
+	bbPRMergeCmd.Flags().String("strategy", "", "Merge strategy: "+strings.Join(bitbucket.MergeStrategies, ", ")+" (default: the repository's default)")
+	bbPRMergeCmd.Flags().Bool("close-source-branch", false, "Delete the source branch after merging")
+	bbPRMergeCmd.Flags().StringP("message", "m", "", "Merge commit message")
+	bbPRMergeCmd.Flags().Duration("timeout", 5*time.Minute, "How long to wait for the merge to finish")
+	bbPRMergeCmd.Flags().Bool("require-green", false, "Refuse to merge unless all build statuses on the head commit are SUCCESSFUL")
+	bbPRCmd.AddCommand(bbPRMergeCmd)
+}
+
+func runBBPRMerge(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+	prID, _ := cmd.Flags().GetInt("pr")
+	strategy, _ := cmd.Flags().GetString("strategy")
+	message, _ := cmd.Flags().GetString("message")
+	timeout, _ := cmd.Flags().GetDuration("timeout")
+	requireGreen, _ := cmd.Flags().GetBool("require-green")
+
+	if strategy != "" && !slices.Contains(bitbucket.MergeStrategies, strategy) {
+		return fmt.Errorf("invalid --strategy %q; must be one of: %s", strategy, strings.Join(bitbucket.MergeStrategies, ", "))
+	}
+
+	req := bitbucket.MergePRRequest{
+		Message:       message,
+		MergeStrategy: strategy,
+	}
+	if cmd.Flags().Changed("close-source-branch") {
+		closeSource, _ := cmd.Flags().GetBool("close-source-branch")
+		req.CloseSourceBranch = &closeSource
+	}
+
+	if requireGreen {
+		current, err := client.GetPullRequest(workspace, repo, prID)
+		if err != nil {
+			return err
+		}
+		var statuses []bitbucket.CommitStatus
+		if current.Source.Commit != nil {
+			statuses, err = client.ListCommitStatuses(workspace, repo, current.Source.Commit.Hash)
+			if err != nil {
+				return err
+			}
+		}
+		if err := checkStatusesGreen(statuses); err != nil {
+			cmd.SilenceUsage = true
+			return fmt.Errorf("refusing to merge pull request #%d: %w", prID, err)
+		}
+	}
+
+	pr, err := client.MergePullRequest(workspace, repo, prID, req, timeout)
+	if err != nil {
+		return err
+	}
+
+	prURL := pr.Links.HTML.Href
+	if prURL == "" {
+		prURL = bbPRURL(workspace, repo, prID)
+	}
+
+	if jsonMode(cmd) {
+		return printJSON(JSONMutationResult{
+			Key: fmt.Sprintf("%d", prID),
+			URL: prURL,
+		})
+	}
+
+	fmt.Printf("Merged pull request #%d (%s)\n", prID, pr.State)
+	fmt.Printf("URL: %s\n", prURL)
+	return nil
+}
@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var bbPRRequestChangesCmd = &cobra.Command{
+	Use:   "request-changes",
+	Short: "Request changes on a pull request",
+	RunE:  runBBPRRequestChanges,
+}
+
+func init() {
+	bbPRRequestChangesCmd.Flags().String("workspace", "", "Workspace slug")
+	bbPRRequestChangesCmd.Flags().String("repo", "", "Repository slug (required)")
+	bbPRRequestChangesCmd.MarkFlagRequired("repo")
+	bbPRRequestChangesCmd.Flags().Int("pr", 0, "Pull request ID (required)")
+	bbPRRequestChangesCmd.MarkFlagRequired("pr")
+	bbPRRequestChangesCmd.Flags().Bool("remove", false, "Withdraw your change request instead")
+	bbPRCmd.AddCommand(bbPRRequestChangesCmd)
+}
+
+func runBBPRRequestChanges(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+	prID, _ := cmd.Flags().GetInt("pr")
+	remove, _ := cmd.Flags().GetBool("remove")
+
+	if remove {
+		err = client.RemoveChangeRequest(workspace, repo, prID)
+	} else {
+		err = client.RequestChanges(workspace, repo, prID)
+	}
+	if err != nil {
+		return err
+	}
+
+	prURL := bbPRURL(workspace, repo, prID)
+
+	if jsonMode(cmd) {
+		return printJSON(JSONMutationResult{
+			Key: fmt.Sprintf("%d", prID),
+			URL: prURL,
+		})
+	}
+
+	if remove {
+		fmt.Printf("Withdrew change request on pull request #%d\n", prID)
+	} else {
+		fmt.Printf("Requested changes on pull request #%d\n", prID)
+	}
+	fmt.Printf("URL: %s\n", prURL)
+	return nil
+}
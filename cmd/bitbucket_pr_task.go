@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/novshi-tech/atl-cli/internal/bitbucket"
+	"github.com/spf13/cobra"
+)
+
+var bbPRTaskCmd = &cobra.Command{
+	Use:   "task",
+	Short: "Manage pull request tasks",
+}
+
+func init() {
+	bbPRCmd.AddCommand(bbPRTaskCmd)
+}
+
+// addBBPRTaskFlags adds the flags shared by the task subcommands that act
+// on a single task.
+func addBBPRTaskFlags(cmd *cobra.Command) {
+	cmd.Flags().String("workspace", "", "Workspace slug")
+	cmd.Flags().String("repo", "", "Repository slug (required)")
+	cmd.MarkFlagRequired("repo")
+	cmd.Flags().Int("pr", 0, "Pull request ID (required)")
+	cmd.MarkFlagRequired("pr")
+	cmd.Flags().Int("id", 0, "Task ID (required)")
+	cmd.MarkFlagRequired("id")
+}
+
+// runBBPRTaskSetState resolves or reopens a task.
+func runBBPRTaskSetState(cmd *cobra.Command, state, verb string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+	prID, _ := cmd.Flags().GetInt("pr")
+	taskID, _ := cmd.Flags().GetInt("id")
+
+	if _, err := client.UpdatePRTask(workspace, repo, prID, taskID, bitbucket.UpdatePRTaskRequest{State: state}); err != nil {
+		return err
+	}
+
+	prURL := bbPRURL(workspace, repo, prID)
+
+	if jsonMode(cmd) {
+		return printJSON(JSONMutationResult{
+			Key: fmt.Sprintf("%d", taskID),
+			URL: prURL,
+		})
+	}
+
+	fmt.Printf("Task #%d %s on pull request #%d\n", taskID, verb, prID)
+	fmt.Printf("URL: %s\n", prURL)
+	return nil
+}
+
+func toJSONPRTaskItem(t bitbucket.PRTask) JSONPRTaskItem {
+	item := JSONPRTaskItem{
+		ID:      t.ID,
+		State:   t.State,
+		Body:    t.Content.Raw,
+		Creator: t.Creator.DisplayName,
+		Created: t.CreatedOn,
+	}
+	if t.Comment != nil {
+		item.CommentID = t.Comment.ID
+	}
+	if t.ResolvedBy != nil {
+		item.ResolvedBy = t.ResolvedBy.DisplayName
+	}
+	return item
+}
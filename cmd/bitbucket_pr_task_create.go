@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/novshi-tech/atl-cli/internal/bitbucket"
+	"github.com/spf13/cobra"
+)
+
+var bbPRTaskCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a task on a pull request",
+	RunE:  runBBPRTaskCreate,
+}
+
+func init() {
+	bbPRTaskCreateCmd.Flags().String("workspace", "", "Workspace slug")
+	bbPRTaskCreateCmd.Flags().String("repo", "", "Repository slug (required)")
+	bbPRTaskCreateCmd.MarkFlagRequired("repo")
+	bbPRTaskCreateCmd.Flags().Int("pr", 0, "Pull request ID (required)")
+	bbPRTaskCreateCmd.MarkFlagRequired("pr")
+	bbPRTaskCreateCmd.Flags().StringP("body", "b", "", "Task description (required)")
+	bbPRTaskCreateCmd.MarkFlagRequired("body")
+	bbPRTaskCreateCmd.Flags().Int("comment", 0, "Comment ID to anchor the task to")
+	bbPRTaskCmd.AddCommand(bbPRTaskCreateCmd)
+}
+
+func runBBPRTaskCreate(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+	prID, _ := cmd.Flags().GetInt("pr")
+	body, _ := cmd.Flags().GetString("body")
+	commentID, _ := cmd.Flags().GetInt("comment")
+
+	req := bitbucket.CreatePRTaskRequest{
+		Content: bitbucket.PRCommentContent{Raw: body},
+	}
+	if commentID > 0 {
+		req.Comment = &bitbucket.PRCommentParent{ID: commentID}
+	}
+
+	task, err := client.CreatePRTask(workspace, repo, prID, req)
+	if err != nil {
+		return err
+	}
+
+	prURL := bbPRURL(workspace, repo, prID)
+
+	if jsonMode(cmd) {
+		return printJSON(JSONMutationResult{
+			Key: fmt.Sprintf("%d", task.ID),
+			URL: prURL,
+		})
+	}
+
+	fmt.Printf("Task #%d added to pull request #%d\n", task.ID, prID)
+	fmt.Printf("URL: %s\n", prURL)
+	return nil
+}
@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var bbPRTaskDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete a task from a pull request",
+	RunE:  runBBPRTaskDelete,
+}
+
+func init() {
+	addBBPRTaskFlags(bbPRTaskDeleteCmd)
+	bbPRTaskCmd.AddCommand(bbPRTaskDeleteCmd)
+}
+
+func runBBPRTaskDelete(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+	prID, _ := cmd.Flags().GetInt("pr")
+	taskID, _ := cmd.Flags().GetInt("id")
+
+	if err := client.DeletePRTask(workspace, repo, prID, taskID); err != nil {
+		return err
+	}
+
+	prURL := bbPRURL(workspace, repo, prID)
+
+	if jsonMode(cmd) {
+		return printJSON(JSONMutationResult{
+			Key: fmt.Sprintf("%d", taskID),
+			URL: prURL,
+		})
+	}
+
+	fmt.Printf("Task #%d deleted from pull request #%d\n", taskID, prID)
+	fmt.Printf("URL: %s\n", prURL)
+	return nil
+}
@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var bbPRTaskListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List tasks on a pull request",
+	RunE:  runBBPRTaskList,
+}
+
+func init() {
+	bbPRTaskListCmd.Flags().String("workspace", "", "Workspace slug")
+	bbPRTaskListCmd.Flags().String("repo", "", "Repository slug (required)")
+	bbPRTaskListCmd.MarkFlagRequired("repo")
+	bbPRTaskListCmd.Flags().Int("pr", 0, "Pull request ID (required)")
+	bbPRTaskListCmd.MarkFlagRequired("pr")
+	bbPRTaskListCmd.Flags().String("state", "", "Filter by state: RESOLVED, UNRESOLVED (default: all)")
+	bbPRTaskCmd.AddCommand(bbPRTaskListCmd)
+}
+
+func runBBPRTaskList(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+	prID, _ := cmd.Flags().GetInt("pr")
+	state, _ := cmd.Flags().GetString("state")
+	state = strings.ToUpper(state)
+	if state != "" && state != "RESOLVED" && state != "UNRESOLVED" {
+		return fmt.Errorf("invalid --state %q; must be RESOLVED or UNRESOLVED", state)
+	}
+
+	tasks, err := client.ListPRTasks(workspace, repo, prID)
+	if err != nil {
+		return err
+	}
+
+	items := make([]JSONPRTaskItem, 0, len(tasks))
+	for _, t := range tasks {
+		if state == "" || t.State == state {
+			items = append(items, toJSONPRTaskItem(t))
+		}
+	}
+
+	if jsonMode(cmd) {
+		return printJSON(items)
+	}
+
+	if len(items) == 0 {
+		fmt.Println("No tasks found.")
+		return nil
+	}
+
+	fmt.Printf("Found %d task(s):\n\n", len(items))
+	for _, t := range items {
+		check := "[ ]"
+		if t.State == "RESOLVED" {
+			check = "[x]"
+		}
+		anchor := ""
+		if t.CommentID > 0 {
+			anchor = fmt.Sprintf(" (on comment #%d)", t.CommentID)
+		}
+		fmt.Printf("#%-6d  %s %s%s\n", t.ID, check, t.Body, anchor)
+	}
+	return nil
+}
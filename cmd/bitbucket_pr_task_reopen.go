@@ -0,0 +1,18 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var bbPRTaskReopenCmd = &cobra.Command{
+	Use:   "reopen",
+	Short: "Reopen a resolved task on a pull request",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runBBPRTaskSetState(cmd, "UNRESOLVED", "reopened")
+	},
+}
+
+func init() {
+	addBBPRTaskFlags(bbPRTaskReopenCmd)
+	bbPRTaskCmd.AddCommand(bbPRTaskReopenCmd)
+}
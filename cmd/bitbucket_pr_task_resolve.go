@@ -0,0 +1,18 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var bbPRTaskResolveCmd = &cobra.Command{
+	Use:   "resolve",
+	Short: "Resolve a task on a pull request",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runBBPRTaskSetState(cmd, "RESOLVED", "resolved")
+	},
+}
+
+func init() {
+	addBBPRTaskFlags(bbPRTaskResolveCmd)
+	bbPRTaskCmd.AddCommand(bbPRTaskResolveCmd)
+}
@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var bbPRUnapproveCmd = &cobra.Command{
+	Use:   "unapprove",
+	Short: "Withdraw your approval of a pull request",
+	RunE:  runBBPRUnapprove,
+}
+
+func init() {
+	bbPRUnapproveCmd.Flags().String("workspace", "", "Workspace slug")
+	bbPRUnapproveCmd.Flags().String("repo", "", "Repository slug (required)")
+	bbPRUnapproveCmd.MarkFlagRequired("repo")
+	bbPRUnapproveCmd.Flags().Int("pr", 0, "Pull request ID (required)")
+	bbPRUnapproveCmd.MarkFlagRequired("pr")
+	bbPRCmd.AddCommand(bbPRUnapproveCmd)
+}
+
+func runBBPRUnapprove(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+	prID, _ := cmd.Flags().GetInt("pr")
+
+	if err := client.UnapprovePullRequest(workspace, repo, prID); err != nil {
+		return err
+	}
+
+	prURL := bbPRURL(workspace, repo, prID)
+
+	if jsonMode(cmd) {
+		return printJSON(JSONMutationResult{
+			Key: fmt.Sprintf("%d", prID),
+			URL: prURL,
+		})
+	}
+
+	fmt.Printf("Withdrew approval of pull request #%d\n", prID)
+	fmt.Printf("URL: %s\n", prURL)
+	return nil
+}
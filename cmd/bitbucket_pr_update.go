@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/novshi-tech/atl-cli/internal/bitbucket"
+	"github.com/spf13/cobra"
+)
+
+var bbPRUpdateCmd = &cobra.Command{
+	Use:   "update",
+	Short: "Update a pull request",
+	Long: "Update a pull request's title, description, reviewers, destination " +
+		"branch or draft status. Fields that aren't given keep their current values.",
+	RunE: runBBPRUpdate,
+}
+
+func init() {
+	bbPRUpdateCmd.Flags().String("workspace", "", "Workspace slug")
+	bbPRUpdateCmd.Flags().String("repo", "", "Repository slug (required)")
+	bbPRUpdateCmd.MarkFlagRequired("repo")
+	bbPRUpdateCmd.Flags().Int("pr", 0, "Pull request ID (required)")
+	bbPRUpdateCmd.MarkFlagRequired("pr")
+	bbPRUpdateCmd.Flags().String("title", "", "New title")
+	bbPRUpdateCmd.Flags().StringP("description", "d", "", "New description")
+	bbPRUpdateCmd.Flags().StringSlice("add-reviewer", nil, "Reviewer to add: nickname, display name, account ID or UUID (repeatable)")
+	bbPRUpdateCmd.Flags().StringSlice("remove-reviewer", nil, "Reviewer to remove: nickname, display name, account ID or UUID (repeatable)")
+	bbPRUpdateCmd.Flags().String("dest", "", "New destination branch")
+	bbPRUpdateCmd.Flags().Bool("draft", false, "Convert the pull request to a draft")
+	bbPRUpdateCmd.Flags().Bool("ready", false, "Mark a draft pull request as ready for review")
+	bbPRUpdateCmd.MarkFlagsMutuallyExclusive("draft", "ready")
+	bbPRCmd.AddCommand(bbPRUpdateCmd)
+}
+
+func runBBPRUpdate(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+	prID, _ := cmd.Flags().GetInt("pr")
+	addRefs, _ := cmd.Flags().GetStringSlice("add-reviewer")
+	removeRefs, _ := cmd.Flags().GetStringSlice("remove-reviewer")
+
+	changed := false
+	for _, name := range []string{"title", "description", "add-reviewer", "remove-reviewer", "dest", "draft", "ready"} {
+		if cmd.Flags().Changed(name) {
+			changed = true
+		}
+	}
+	if !changed {
+		return fmt.Errorf("nothing to update; specify at least one of --title, --description, --add-reviewer, --remove-reviewer, --dest, --draft or --ready")
+	}
+
+	pr, err := client.GetPullRequest(workspace, repo, prID)
+	if err != nil {
+		return err
+	}
+
+	req := bitbucket.UpdatePRRequest{
+		Title:             pr.Title,
+		Description:       pr.Description,
+		Destination:       &bitbucket.CreatePRRef{Branch: bitbucket.CreatePRBranch{Name: pr.Destination.Branch.Name}},
+		Reviewers:         []bitbucket.PRReviewerRef{},
+		CloseSourceBranch: pr.CloseSourceBranch,
+		Draft:             pr.Draft,
+	}
+	for _, r := range pr.Reviewers {
+		req.Reviewers = appendReviewer(req.Reviewers, r.UUID)
+	}
+
+	if cmd.Flags().Changed("title") {
+		req.Title, _ = cmd.Flags().GetString("title")
+	}
+	if cmd.Flags().Changed("description") {
+		req.Description, _ = cmd.Flags().GetString("description")
+	}
+	if cmd.Flags().Changed("dest") {
+		dest, _ := cmd.Flags().GetString("dest")
+		req.Destination.Branch.Name = dest
+	}
+	if cmd.Flags().Changed("draft") {
+		req.Draft = true
+	}
+	if cmd.Flags().Changed("ready") {
+		req.Draft = false
+	}
+
+	add, err := resolveBBReviewers(client, workspace, addRefs)
+	if err != nil {
+		return err
+	}
+	for _, r := range add {
+		req.Reviewers = appendReviewer(req.Reviewers, r.UUID)
+	}
+	remove, err := resolveBBReviewers(client, workspace, removeRefs)
+	if err != nil {
+		return err
+	}
+	req.Reviewers = slices.DeleteFunc(req.Reviewers, func(r bitbucket.PRReviewerRef) bool {
+		return slices.Contains(remove, r)
+	})
+
+	updated, err := client.UpdatePullRequest(workspace, repo, prID, req)
+	if err != nil {
+		return err
+	}
+
+	prURL := updated.Links.HTML.Href
+	if prURL == "" {
+		prURL = bbPRURL(workspace, repo, prID)
+	}
+
+	if jsonMode(cmd) {
+		return printJSON(JSONMutationResult{
+			Key: fmt.Sprintf("%d", prID),
+			URL: prURL,
+		})
+	}
+
+	fmt.Printf("Updated pull request #%d\n", prID)
+	fmt.Printf("URL: %s\n", prURL)
+	return nil
+}
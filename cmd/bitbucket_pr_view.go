@@ -0,0 +1,255 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/novshi-tech/atl-cli/internal/bitbucket"
+	"github.com/spf13/cobra"
+)
+
+var bbPRViewCmd = &cobra.Command{
+	Use:   "view",
+	Short: "Show details of a pull request",
+	Long: "Show a pull request with its description, reviewers and their approval " +
+		"state, the statuses (builds) reported on the source branch's head commit, " +
+		"the open task count and whether it currently looks mergeable.",
+	RunE: runBBPRView,
+}
+
+func init() {
+	bbPRViewCmd.Flags().String("workspace", "", "Workspace slug")
+	bbPRViewCmd.Flags().String("repo", "", "Repository slug (required)")
+	bbPRViewCmd.MarkFlagRequired("repo")
+	bbPRViewCmd.Flags().Int("pr", 0, "Pull request ID (required)")
+	bbPRViewCmd.MarkFlagRequired("pr")
+	bbPRViewCmd.Flags().Bool("require-green", false, "Exit non-zero unless all build statuses on the head commit are SUCCESSFUL")
+	bbPRCmd.AddCommand(bbPRViewCmd)
+}
+
+func runBBPRView(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+	prID, _ := cmd.Flags().GetInt("pr")
+	requireGreen, _ := cmd.Flags().GetBool("require-green")
+
+	pr, err := client.GetPullRequest(workspace, repo, prID)
+	if err != nil {
+		return err
+	}
+
+	var statuses []bitbucket.CommitStatus
+	if pr.Source.Commit != nil && pr.Source.Commit.Hash != "" {
+		statuses, err = client.ListCommitStatuses(workspace, repo, pr.Source.Commit.Hash)
+		if err != nil {
+			return err
+		}
+	}
+
+	reviewers, participants := splitPRParticipants(pr)
+	blockers := prMergeBlockers(pr, statuses)
+	prURL := pr.Links.HTML.Href
+	if prURL == "" {
+		prURL = bbPRURL(workspace, repo, prID)
+	}
+
+	if jsonMode(cmd) {
+		detail := JSONPRDetail{
+			ID:                pr.ID,
+			Title:             pr.Title,
+			State:             pr.State,
+			Draft:             pr.Draft,
+			Author:            pr.Author.DisplayName,
+			Source:            pr.Source.Branch.Name,
+			Dest:              pr.Destination.Branch.Name,
+			CloseSourceBranch: pr.CloseSourceBranch,
+			Description:       pr.Description,
+			CreatedOn:         pr.CreatedOn,
+			UpdatedOn:         pr.UpdatedOn,
+			CommentCount:      pr.CommentCount,
+			OpenTaskCount:     pr.TaskCount,
+			Reviewers:         toJSONPRParticipants(reviewers),
+			Participants:      toJSONPRParticipants(participants),
+			Statuses:          make([]JSONCommitStatus, len(statuses)),
+			Mergeable:         len(blockers) == 0,
+			MergeBlockers:     blockers,
+			URL:               prURL,
+		}
+		if pr.Source.Commit != nil {
+			detail.SourceCommit = pr.Source.Commit.Hash
+		}
+		if pr.MergeCommit != nil {
+			detail.MergeCommit = pr.MergeCommit.Hash
+		}
+		for i, s := range statuses {
+			detail.Statuses[i] = toJSONCommitStatus(s)
+		}
+		if err := printJSON(detail); err != nil {
+			return err
+		}
+		return requireGreenStatuses(cmd, requireGreen, statuses)
+	}
+
+	fmt.Printf("#%d %s\n\n", pr.ID, pr.Title)
+	if pr.Draft {
+		fmt.Printf("State:    %s (draft)\n", pr.State)
+	} else {
+		fmt.Printf("State:    %s\n", pr.State)
+	}
+	fmt.Printf("Author:   %s\n", pr.Author.DisplayName)
+	fmt.Printf("Branch:   %s→%s\n", pr.Source.Branch.Name, pr.Destination.Branch.Name)
+	if pr.Source.Commit != nil {
+		fmt.Printf("Commit:   %s\n", pr.Source.Commit.Hash)
+	}
+	if pr.MergeCommit != nil {
+		fmt.Printf("Merged:   %s\n", pr.MergeCommit.Hash)
+	}
+	fmt.Printf("Created:  %s\n", pr.CreatedOn)
+	fmt.Printf("Updated:  %s\n", pr.UpdatedOn)
+	fmt.Printf("Comments: %d\n", pr.CommentCount)
+	fmt.Printf("Tasks:    %d open\n", pr.TaskCount)
+	if pr.CloseSourceBranch {
+		fmt.Println("Source branch will be closed on merge")
+	}
+
+	fmt.Println("\nReviewers:")
+	if len(reviewers) == 0 {
+		fmt.Println("  (none)")
+	}
+	for _, p := range reviewers {
+		fmt.Printf("  %-20s  %s\n", p.User.DisplayName, participantState(p))
+	}
+	if len(participants) > 0 {
+		fmt.Println("\nParticipants:")
+		for _, p := range participants {
+			fmt.Printf("  %-20s  %s\n", p.User.DisplayName, participantState(p))
+		}
+	}
+
+	fmt.Println("\nBuilds:")
+	if len(statuses) == 0 {
+		fmt.Println("  (none)")
+	}
+	for _, s := range statuses {
+		fmt.Printf("  %-11s  %s  %s\n", s.State, s.Name, s.URL)
+	}
+
+	if len(blockers) == 0 {
+		fmt.Println("\nMergeable: yes")
+	} else {
+		fmt.Printf("\nMergeable: no (%s)\n", strings.Join(blockers, "; "))
+	}
+
+	if pr.Description != "" {
+		fmt.Printf("\nDescription:\n%s\n", pr.Description)
+	}
+	fmt.Printf("\nURL: %s\n", prURL)
+	return requireGreenStatuses(cmd, requireGreen, statuses)
+}
+
+// requireGreenStatuses implements --require-green for pr view.
+func requireGreenStatuses(cmd *cobra.Command, require bool, statuses []bitbucket.CommitStatus) error {
+	if !require {
+		return nil
+	}
+	if err := checkStatusesGreen(statuses); err != nil {
+		cmd.SilenceUsage = true
+		return err
+	}
+	return nil
+}
+
+// splitPRParticipants returns the pull request's reviewers (including
+// those who haven't acted yet, who only appear in pr.Reviewers) and the
+// other participants.
+func splitPRParticipants(pr *bitbucket.PullRequest) (reviewers, others []bitbucket.PRParticipant) {
+	seen := make(map[string]bool)
+	for _, p := range pr.Participants {
+		if p.Role == "REVIEWER" {
+			reviewers = append(reviewers, p)
+			seen[p.User.UUID] = true
+		} else {
+			others = append(others, p)
+		}
+	}
+	for _, u := range pr.Reviewers {
+		if !seen[u.UUID] {
+			reviewers = append(reviewers, bitbucket.PRParticipant{User: u, Role: "REVIEWER"})
+		}
+	}
+	return reviewers, others
+}
+
+func participantState(p bitbucket.PRParticipant) string {
+	switch {
+	case p.Approved:
+		return "approved"
+	case p.State == "changes_requested":
+		return "changes requested"
+	case p.Role == "REVIEWER":
+		return "pending"
+	}
+	return "commented"
+}
+
+// prMergeBlockers lists the reasons a pull request can't be merged as
+// far as the API lets us tell: it must be open, have no outstanding change
+// requests or open tasks, and no failed or running builds. Repository merge
+// checks (e.g. a minimum number of approvals) aren't exposed by the API and
+// are enforced by Bitbucket on merge.
+func prMergeBlockers(pr *bitbucket.PullRequest, statuses []bitbucket.CommitStatus) []string {
+	var blockers []string
+	if pr.State != "OPEN" {
+		blockers = append(blockers, "pull request is "+strings.ToLower(pr.State))
+	}
+	for _, p := range pr.Participants {
+		if p.State == "changes_requested" {
+			blockers = append(blockers, p.User.DisplayName+" requested changes")
+		}
+	}
+	if pr.TaskCount > 0 {
+		blockers = append(blockers, fmt.Sprintf("%d open task(s)", pr.TaskCount))
+	}
+	for _, s := range statuses {
+		switch s.State {
+		case "FAILED", "STOPPED":
+			blockers = append(blockers, fmt.Sprintf("build %q %s", s.Name, strings.ToLower(s.State)))
+		case "INPROGRESS":
+			blockers = append(blockers, fmt.Sprintf("build %q in progress", s.Name))
+		}
+	}
+	return blockers
+}
+
+func toJSONPRParticipants(ps []bitbucket.PRParticipant) []JSONPRParticipant {
+	items := make([]JSONPRParticipant, len(ps))
+	for i, p := range ps {
+		items[i] = JSONPRParticipant{
+			DisplayName: p.User.DisplayName,
+			AccountID:   p.User.AccountID,
+			Role:        p.Role,
+			Approved:    p.Approved,
+			State:       p.State,
+		}
+	}
+	return items
+}
+
+func toJSONCommitStatus(s bitbucket.CommitStatus) JSONCommitStatus {
+	return JSONCommitStatus{
+		Key:         s.Key,
+		Name:        s.Name,
+		State:       s.State,
+		URL:         s.URL,
+		Description: s.Description,
+		UpdatedOn:   s.UpdatedOn,
+	}
+}
@@ -0,0 +1,26 @@
+package cmd
+
+import (
+	"github.com/novshi-tech/atl-cli/internal/bitbucket"
+	"github.com/spf13/cobra"
+)
+
+var bbProjectCmd = &cobra.Command{
+	Use:   "project",
+	Short: "Manage Bitbucket projects",
+}
+
+func init() {
+	bitbucketCmd.AddCommand(bbProjectCmd)
+}
+
+func toJSONBBProject(p bitbucket.Project) JSONBBProject {
+	return JSONBBProject{
+		Key:         p.Key,
+		Name:        p.Name,
+		Description: p.Description,
+		IsPrivate:   p.IsPrivate,
+		UpdatedOn:   p.UpdatedOn,
+		URL:         p.Links.HTML.Href,
+	}
+}
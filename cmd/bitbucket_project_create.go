@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/novshi-tech/atl-cli/internal/bitbucket"
+	"github.com/spf13/cobra"
+)
+
+var bbProjectCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a project",
+	Long:  "Create a project in a workspace. Projects are private unless --private=false is given.",
+	RunE:  runBBProjectCreate,
+}
+
+func init() {
+	bbProjectCreateCmd.Flags().String("workspace", "", "Workspace slug")
+	bbProjectCreateCmd.Flags().String("key", "", "Project key, e.g. WEB (required)")
+	bbProjectCreateCmd.MarkFlagRequired("key")
+	bbProjectCreateCmd.Flags().String("name", "", "Project name (required)")
+	bbProjectCreateCmd.MarkFlagRequired("name")
+	bbProjectCreateCmd.Flags().StringP("description", "d", "", "Project description")
+	bbProjectCreateCmd.Flags().Bool("private", true, "Make the project private (--private=false for public)")
+	bbProjectCmd.AddCommand(bbProjectCreateCmd)
+}
+
+func runBBProjectCreate(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	key, _ := cmd.Flags().GetString("key")
+	name, _ := cmd.Flags().GetString("name")
+	description, _ := cmd.Flags().GetString("description")
+
+	req := bitbucket.CreateProjectRequest{
+		Key:         key,
+		Name:        name,
+		Description: description,
+	}
+	if cmd.Flags().Changed("private") {
+		private, _ := cmd.Flags().GetBool("private")
+		req.IsPrivate = &private
+	}
+
+	p, err := client.CreateProject(workspace, req)
+	if err != nil {
+		return err
+	}
+
+	if jsonMode(cmd) {
+		return printJSON(JSONMutationResult{Key: p.Key, URL: p.Links.HTML.Href})
+	}
+
+	fmt.Printf("Created project %s (%s)\n", p.Key, p.Name)
+	fmt.Printf("URL: %s\n", p.Links.HTML.Href)
+	return nil
+}
@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var bbProjectListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List projects in a workspace",
+	RunE:  runBBProjectList,
+}
+
+func init() {
+	bbProjectListCmd.Flags().String("workspace", "", "Workspace slug")
+	bbProjectListCmd.Flags().StringP("query", "q", "", "Only projects whose key or name contains this text")
+	bbProjectListCmd.Flags().Int("max", 50, "Maximum number of results")
+	bbProjectListCmd.Flags().Bool("all", false, "Return all results, ignoring --max")
+	bbProjectCmd.AddCommand(bbProjectListCmd)
+}
+
+func runBBProjectList(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	query, _ := cmd.Flags().GetString("query")
+	max, _ := cmd.Flags().GetInt("max")
+	if all, _ := cmd.Flags().GetBool("all"); all {
+		max = 0
+	}
+
+	projects, err := client.ListProjects(workspace, query, max)
+	if err != nil {
+		return err
+	}
+
+	if jsonMode(cmd) {
+		items := make([]JSONBBProject, len(projects))
+		for i, p := range projects {
+			items[i] = toJSONBBProject(p)
+		}
+		return printJSON(items)
+	}
+
+	if len(projects) == 0 {
+		fmt.Println("No projects found.")
+		return nil
+	}
+
+	fmt.Printf("Found %d project(s):\n\n", len(projects))
+	for _, p := range projects {
+		visibility := "public"
+		if p.IsPrivate {
+			visibility = "private"
+		}
+		fmt.Printf("%-12s  %-40s  %s\n", p.Key, p.Name, visibility)
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var bbProjectViewCmd = &cobra.Command{
+	Use:   "view",
+	Short: "Show details of a project",
+	RunE:  runBBProjectView,
+}
+
+func init() {
+	bbProjectViewCmd.Flags().String("workspace", "", "Workspace slug")
+	bbProjectViewCmd.Flags().String("key", "", "Project key (required)")
+	bbProjectViewCmd.MarkFlagRequired("key")
+	bbProjectCmd.AddCommand(bbProjectViewCmd)
+}
+
+func runBBProjectView(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	key, _ := cmd.Flags().GetString("key")
+
+	p, err := client.GetProject(workspace, key)
+	if err != nil {
+		return err
+	}
+
+	if jsonMode(cmd) {
+		return printJSON(toJSONBBProject(*p))
+	}
+
+	fmt.Printf("Key:          %s\n", p.Key)
+	fmt.Printf("Name:         %s\n", p.Name)
+	fmt.Printf("Description:  %s\n", p.Description)
+	private := "No"
+	if p.IsPrivate {
+		private = "Yes"
+	}
+	fmt.Printf("Private:      %s\n", private)
+	fmt.Printf("Created:      %s\n", p.CreatedOn)
+	fmt.Printf("Updated:      %s\n", p.UpdatedOn)
+	fmt.Printf("URL:          %s\n", p.Links.HTML.Href)
+	return nil
+}
@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/novshi-tech/atl-cli/internal/bitbucket"
+	"github.com/spf13/cobra"
+)
+
+var bbRepoCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a repository",
+	Long: "Create an empty Git repository. Unless --private is given, its visibility " +
+		"follows the workspace default.",
+	RunE: runBBRepoCreate,
+}
+
+func init() {
+	bbRepoCreateCmd.Flags().String("workspace", "", "Workspace slug")
+	bbRepoCreateCmd.Flags().String("repo", "", "Repository slug (required)")
+	bbRepoCreateCmd.MarkFlagRequired("repo")
+	bbRepoCreateCmd.Flags().String("project", "", "Project key (default: the workspace's default project)")
+	bbRepoCreateCmd.Flags().Bool("private", false, "Make the repository private (--private=false for public)")
+	bbRepoCreateCmd.Flags().String("language", "", "Main language, e.g. go")
+	bbRepoCreateCmd.Flags().StringP("description", "d", "", "Repository description")
+	bbRepoCmd.AddCommand(bbRepoCreateCmd)
+}
+
+func runBBRepoCreate(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+	project, _ := cmd.Flags().GetString("project")
+	language, _ := cmd.Flags().GetString("language")
+	description, _ := cmd.Flags().GetString("description")
+
+	req := bitbucket.CreateRepositoryRequest{
+		SCM:         "git",
+		Language:    language,
+		Description: description,
+	}
+	if project != "" {
+		req.Project = &bitbucket.RepoProject{Key: project}
+	}
+	if cmd.Flags().Changed("private") {
+		private, _ := cmd.Flags().GetBool("private")
+		req.IsPrivate = &private
+	}
+
+	r, err := client.CreateRepository(workspace, repo, req)
+	if err != nil {
+		return err
+	}
+
+	if jsonMode(cmd) {
+		return printJSON(JSONMutationResult{Key: r.FullName, URL: r.Links.HTML.Href})
+	}
+
+	fmt.Printf("Created repository %s\n", r.FullName)
+	fmt.Printf("URL: %s\n", r.Links.HTML.Href)
+	return nil
+}
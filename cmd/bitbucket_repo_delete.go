@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var bbRepoDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete a repository",
+	Long:  "Permanently delete a repository, including its pull requests and pipelines.",
+	RunE:  runBBRepoDelete,
+}
+
+func init() {
+	bbRepoDeleteCmd.Flags().String("workspace", "", "Workspace slug")
+	bbRepoDeleteCmd.Flags().String("repo", "", "Repository slug (required)")
+	bbRepoDeleteCmd.MarkFlagRequired("repo")
+	bbRepoDeleteCmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+	bbRepoCmd.AddCommand(bbRepoDeleteCmd)
+}
+
+func runBBRepoDelete(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+
+	if err := confirmDestructive(cmd, fmt.Sprintf("Permanently delete repository %s/%s?", workspace, repo)); err != nil {
+		return err
+	}
+	if err := client.DeleteRepository(workspace, repo); err != nil {
+		return err
+	}
+
+	if jsonMode(cmd) {
+		return printJSON(JSONMutationResult{Key: workspace + "/" + repo})
+	}
+
+	fmt.Printf("Deleted repository %s/%s\n", workspace, repo)
+	return nil
+}
@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/novshi-tech/atl-cli/internal/bitbucket"
+	"github.com/spf13/cobra"
+)
+
+var bbRepoForkCmd = &cobra.Command{
+	Use:   "fork",
+	Short: "Fork a repository",
+	Long: "Fork --repo into --to-workspace (default: the same workspace, which " +
+		"requires --name since slugs must be unique).",
+	RunE: runBBRepoFork,
+}
+
+func init() {
+	bbRepoForkCmd.Flags().String("workspace", "", "Workspace slug of the repository to fork")
+	bbRepoForkCmd.Flags().String("repo", "", "Repository slug to fork (required)")
+	bbRepoForkCmd.MarkFlagRequired("repo")
+	bbRepoForkCmd.Flags().String("to-workspace", "", "Workspace to create the fork in (default: same workspace)")
+	bbRepoForkCmd.Flags().String("name", "", "Name of the fork (default: same as the original)")
+	bbRepoForkCmd.Flags().String("project", "", "Project key for the fork")
+	bbRepoForkCmd.Flags().Bool("private", false, "Make the fork private (--private=false for public)")
+	bbRepoCmd.AddCommand(bbRepoForkCmd)
+}
+
+func runBBRepoFork(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+	toWorkspace, _ := cmd.Flags().GetString("to-workspace")
+	name, _ := cmd.Flags().GetString("name")
+	project, _ := cmd.Flags().GetString("project")
+
+	if (toWorkspace == "" || toWorkspace == workspace) && name == "" {
+		return fmt.Errorf("--name is required when forking into the same workspace")
+	}
+
+	req := bitbucket.ForkRepositoryRequest{Name: name}
+	if toWorkspace != "" {
+		req.Workspace = &bitbucket.WorkspaceSlug{Slug: toWorkspace}
+	}
+	if project != "" {
+		req.Project = &bitbucket.RepoProject{Key: project}
+	}
+	if cmd.Flags().Changed("private") {
+		private, _ := cmd.Flags().GetBool("private")
+		req.IsPrivate = &private
+	}
+
+	r, err := client.ForkRepository(workspace, repo, req)
+	if err != nil {
+		return err
+	}
+
+	if jsonMode(cmd) {
+		return printJSON(JSONMutationResult{Key: r.FullName, URL: r.Links.HTML.Href})
+	}
+
+	fmt.Printf("Forked %s/%s to %s\n", workspace, repo, r.FullName)
+	fmt.Printf("URL: %s\n", r.Links.HTML.Href)
+	return nil
+}
@@ -41,7 +41,7 @@ func runBBRepoGet(cmd *cobra.Command, args []string) error {
 		if r.MainBranch != nil {
 			mainbranch = r.MainBranch.Name
 		}
-		return printJSON(JSONRepoDetail{
+		detail := JSONRepoDetail{
 			Slug:        r.Slug,
 			Name:        r.Name,
 			FullName:    r.FullName,
@@ -50,7 +50,15 @@ func runBBRepoGet(cmd *cobra.Command, args []string) error {
 			IsPrivate:   r.IsPrivate,
 			MainBranch:  mainbranch,
 			UpdatedOn:   r.UpdatedOn,
-		})
+			HasIssues:   r.HasIssues,
+		}
+		if r.Project != nil {
+			detail.Project = r.Project.Key
+		}
+		if r.Parent != nil {
+			detail.ForkOf = r.Parent.FullName
+		}
+		return printJSON(detail)
 	}
 
 	fmt.Printf("Slug:         %s\n", r.Slug)
@@ -63,9 +71,20 @@ func runBBRepoGet(cmd *cobra.Command, args []string) error {
 		private = "Yes"
 	}
 	fmt.Printf("Private:      %s\n", private)
+	if r.Project != nil {
+		fmt.Printf("Project:      %s\n", r.Project.Key)
+	}
 	if r.MainBranch != nil {
 		fmt.Printf("Main Branch:  %s\n", r.MainBranch.Name)
 	}
+	issues := "No"
+	if r.HasIssues {
+		issues = "Yes"
+	}
+	fmt.Printf("Issues:       %s\n", issues)
+	if r.Parent != nil {
+		fmt.Printf("Fork Of:      %s\n", r.Parent.FullName)
+	}
 	fmt.Printf("Updated:      %s\n", r.UpdatedOn)
 	return nil
 }
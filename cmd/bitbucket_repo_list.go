@@ -2,19 +2,28 @@ package cmd
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 
+	"github.com/novshi-tech/atl-cli/internal/bitbucket"
 	"github.com/spf13/cobra"
 )
 
 var bbRepoListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List repositories in a workspace",
-	RunE:  runBBRepoList,
+	Long: "List repositories in a workspace, optionally limited to a project, to " +
+		"repositories on which you have a given role, or by a raw BBQL expression.",
+	RunE: runBBRepoList,
 }
 
 func init() {
 	bbRepoListCmd.Flags().String("workspace", "", "Workspace slug")
+	bbRepoListCmd.Flags().String("project", "", "Filter by project key")
+	bbRepoListCmd.Flags().String("role", "", "Only repositories on which you have this role: member, contributor, admin or owner")
+	bbRepoListCmd.Flags().String("query", "", "Additional BBQL filter, e.g. 'name ~ \"api\"'")
 	bbRepoListCmd.Flags().Int("max", 25, "Maximum number of results")
+	bbRepoListCmd.Flags().Bool("all", false, "Return all results, ignoring --max")
 	bbRepoCmd.AddCommand(bbRepoListCmd)
 }
 
@@ -28,33 +37,48 @@ func runBBRepoList(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	project, _ := cmd.Flags().GetString("project")
+	role, _ := cmd.Flags().GetString("role")
+	query, _ := cmd.Flags().GetString("query")
 	max, _ := cmd.Flags().GetInt("max")
+	all, _ := cmd.Flags().GetBool("all")
 
-	resp, err := client.ListRepositories(workspace, 1, max)
+	role = strings.ToLower(role)
+	if role != "" && !slices.Contains(bitbucket.RepoRoles, role) {
+		return fmt.Errorf("invalid --role %q; must be one of: %s", role, strings.Join(bitbucket.RepoRoles, ", "))
+	}
+	if all {
+		max = 0
+	}
+
+	repos, err := client.ListRepositories(workspace, bitbucket.RepoFilter{Project: project, Role: role, Query: query}, max)
 	if err != nil {
 		return err
 	}
 
 	if jsonMode(cmd) {
-		items := make([]JSONRepoItem, len(resp.Values))
-		for i, r := range resp.Values {
+		items := make([]JSONRepoItem, len(repos))
+		for i, r := range repos {
 			items[i] = JSONRepoItem{
 				Slug:      r.Slug,
 				Name:      r.Name,
 				Language:  r.Language,
 				IsPrivate: r.IsPrivate,
 			}
+			if r.Project != nil {
+				items[i].Project = r.Project.Key
+			}
 		}
 		return printJSON(items)
 	}
 
-	if len(resp.Values) == 0 {
+	if len(repos) == 0 {
 		fmt.Println("No repositories found.")
 		return nil
 	}
 
-	fmt.Printf("Found %d repositor(ies):\n\n", len(resp.Values))
-	for _, r := range resp.Values {
+	fmt.Printf("Found %d repositor(ies):\n\n", len(repos))
+	for _, r := range repos {
 		private := "public"
 		if r.IsPrivate {
 			private = "private"
@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/novshi-tech/atl-cli/internal/bitbucket"
+	"github.com/spf13/cobra"
+)
+
+var bbRepoUpdateCmd = &cobra.Command{
+	Use:   "update",
+	Short: "Update repository settings",
+	Long:  "Change repository settings. Only the flags given are changed.",
+	RunE:  runBBRepoUpdate,
+}
+
+func init() {
+	bbRepoUpdateCmd.Flags().String("workspace", "", "Workspace slug")
+	bbRepoUpdateCmd.Flags().String("repo", "", "Repository slug (required)")
+	bbRepoUpdateCmd.MarkFlagRequired("repo")
+	bbRepoUpdateCmd.Flags().StringP("description", "d", "", "New description")
+	bbRepoUpdateCmd.Flags().String("main-branch", "", "New main branch (must already exist)")
+	bbRepoUpdateCmd.Flags().Bool("has-issues", false, "Enable the issue tracker (--has-issues=false to disable)")
+	bbRepoUpdateCmd.Flags().Bool("private", false, "Make the repository private (--private=false for public)")
+	bbRepoUpdateCmd.Flags().String("language", "", "New main language")
+	bbRepoCmd.AddCommand(bbRepoUpdateCmd)
+}
+
+func runBBRepoUpdate(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+
+	var req bitbucket.UpdateRepositoryRequest
+	changed := false
+	if cmd.Flags().Changed("description") {
+		description, _ := cmd.Flags().GetString("description")
+		req.Description = &description
+		changed = true
+	}
+	if cmd.Flags().Changed("main-branch") {
+		mainBranch, _ := cmd.Flags().GetString("main-branch")
+		req.MainBranch = &bitbucket.Branch{Name: mainBranch}
+		changed = true
+	}
+	if cmd.Flags().Changed("has-issues") {
+		hasIssues, _ := cmd.Flags().GetBool("has-issues")
+		req.HasIssues = &hasIssues
+		changed = true
+	}
+	if cmd.Flags().Changed("private") {
+		private, _ := cmd.Flags().GetBool("private")
+		req.IsPrivate = &private
+		changed = true
+	}
+	if cmd.Flags().Changed("language") {
+		language, _ := cmd.Flags().GetString("language")
+		req.Language = &language
+		changed = true
+	}
+	if !changed {
+		return fmt.Errorf("nothing to update; pass at least one of --description, --main-branch, --has-issues, --private, --language")
+	}
+
+	r, err := client.UpdateRepository(workspace, repo, req)
+	if err != nil {
+		return err
+	}
+
+	if jsonMode(cmd) {
+		return printJSON(JSONMutationResult{Key: r.FullName, URL: r.Links.HTML.Href})
+	}
+
+	fmt.Printf("Updated repository %s\n", r.FullName)
+	fmt.Printf("URL: %s\n", r.Links.HTML.Href)
+	return nil
+}
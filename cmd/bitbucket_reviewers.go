@@ -0,0 +1,21 @@
+package cmd
+
+import "github.com/spf13/cobra"
+
+var bbReviewersCmd = &cobra.Command{
+	Use:   "reviewers",
+	Short: "Manage repository reviewer settings",
+}
+
+var bbReviewersDefaultCmd = &cobra.Command{
+	Use:   "default",
+	Short: "Manage the default reviewers of repositories",
+	Long: "Manage the users added as reviewers to every new pull request in a " +
+		"repository. add and remove accept --repo more than once to update " +
+		"several repositories at a time.",
+}
+
+func init() {
+	bbReviewersCmd.AddCommand(bbReviewersDefaultCmd)
+	bitbucketCmd.AddCommand(bbReviewersCmd)
+}
@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var bbReviewersDefaultAddCmd = &cobra.Command{
+	Use:   "add",
+	Short: "Add default reviewers to repositories",
+	RunE:  runBBReviewersDefaultAdd,
+}
+
+func init() {
+	bbReviewersDefaultAddCmd.Flags().String("workspace", "", "Workspace slug")
+	bbReviewersDefaultAddCmd.Flags().StringSlice("repo", nil, "Repository slug (required, repeatable)")
+	bbReviewersDefaultAddCmd.MarkFlagRequired("repo")
+	bbReviewersDefaultAddCmd.Flags().StringSlice("user", nil, "User: \"me\", nickname, display name, account ID or UUID (required, repeatable)")
+	bbReviewersDefaultAddCmd.MarkFlagRequired("user")
+	bbReviewersDefaultCmd.AddCommand(bbReviewersDefaultAddCmd)
+}
+
+func runBBReviewersDefaultAdd(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repos, _ := cmd.Flags().GetStringSlice("repo")
+	userRefs, _ := cmd.Flags().GetStringSlice("user")
+
+	uuids, err := resolveBBUserUUIDs(client, workspace, userRefs, map[string]string{})
+	if err != nil {
+		return err
+	}
+
+	results := []JSONMutationResult{}
+	for _, repo := range repos {
+		for i, uuid := range uuids {
+			if err := client.AddDefaultReviewer(workspace, repo, uuid); err != nil {
+				return fmt.Errorf("%s: %s: %w", repo, userRefs[i], err)
+			}
+			results = append(results, JSONMutationResult{Key: repo + ":" + uuid})
+			if !jsonMode(cmd) {
+				fmt.Printf("Added %s to the default reviewers of %s\n", userRefs[i], repo)
+			}
+		}
+	}
+
+	if jsonMode(cmd) {
+		return printJSON(results)
+	}
+	return nil
+}
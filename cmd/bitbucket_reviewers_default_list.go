@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var bbReviewersDefaultListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the default reviewers of a repository",
+	Long: "List the default reviewers configured on a repository. With --effective, " +
+		"also include those inherited from its project.",
+	RunE: runBBReviewersDefaultList,
+}
+
+func init() {
+	bbReviewersDefaultListCmd.Flags().String("workspace", "", "Workspace slug")
+	bbReviewersDefaultListCmd.Flags().String("repo", "", "Repository slug (required)")
+	bbReviewersDefaultListCmd.MarkFlagRequired("repo")
+	bbReviewersDefaultListCmd.Flags().Bool("effective", false, "Include default reviewers inherited from the project")
+	bbReviewersDefaultCmd.AddCommand(bbReviewersDefaultListCmd)
+}
+
+func runBBReviewersDefaultList(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+	effective, _ := cmd.Flags().GetBool("effective")
+
+	items := []JSONDefaultReviewer{}
+	if effective {
+		reviewers, err := client.ListEffectiveDefaultReviewers(workspace, repo)
+		if err != nil {
+			return err
+		}
+		for _, r := range reviewers {
+			items = append(items, JSONDefaultReviewer{DisplayName: r.User.DisplayName, Nickname: r.User.Nickname, UUID: r.User.UUID})
+		}
+	} else {
+		users, err := client.ListDefaultReviewers(workspace, repo)
+		if err != nil {
+			return err
+		}
+		for _, u := range users {
+			items = append(items, JSONDefaultReviewer{DisplayName: u.DisplayName, Nickname: u.Nickname, UUID: u.UUID})
+		}
+	}
+
+	if jsonMode(cmd) {
+		return printJSON(items)
+	}
+
+	if len(items) == 0 {
+		fmt.Println("No default reviewers found.")
+		return nil
+	}
+
+	fmt.Printf("Found %d default reviewer(s):\n\n", len(items))
+	for _, r := range items {
+		fmt.Printf("%-30s  %-20s  %s\n", r.DisplayName, r.Nickname, r.UUID)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var bbReviewersDefaultRemoveCmd = &cobra.Command{
+	Use:   "remove",
+	Short: "Remove default reviewers from repositories",
+	RunE:  runBBReviewersDefaultRemove,
+}
+
+func init() {
+	bbReviewersDefaultRemoveCmd.Flags().String("workspace", "", "Workspace slug")
+	bbReviewersDefaultRemoveCmd.Flags().StringSlice("repo", nil, "Repository slug (required, repeatable)")
+	bbReviewersDefaultRemoveCmd.MarkFlagRequired("repo")
+	bbReviewersDefaultRemoveCmd.Flags().StringSlice("user", nil, "User: \"me\", nickname, display name, account ID or UUID (required, repeatable)")
+	bbReviewersDefaultRemoveCmd.MarkFlagRequired("user")
+	bbReviewersDefaultCmd.AddCommand(bbReviewersDefaultRemoveCmd)
+}
+
+func runBBReviewersDefaultRemove(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repos, _ := cmd.Flags().GetStringSlice("repo")
+	userRefs, _ := cmd.Flags().GetStringSlice("user")
+
+	uuids, err := resolveBBUserUUIDs(client, workspace, userRefs, map[string]string{})
+	if err != nil {
+		return err
+	}
+
+	results := []JSONMutationResult{}
+	for _, repo := range repos {
+		for i, uuid := range uuids {
+			if err := client.RemoveDefaultReviewer(workspace, repo, uuid); err != nil {
+				return fmt.Errorf("%s: %s: %w", repo, userRefs[i], err)
+			}
+			results = append(results, JSONMutationResult{Key: repo + ":" + uuid})
+			if !jsonMode(cmd) {
+				fmt.Printf("Removed %s from the default reviewers of %s\n", userRefs[i], repo)
+			}
+		}
+	}
+
+	if jsonMode(cmd) {
+		return printJSON(results)
+	}
+	return nil
+}
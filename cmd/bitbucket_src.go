@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/novshi-tech/atl-cli/internal/bitbucket"
+	"github.com/spf13/cobra"
+)
+
+var bbSrcCmd = &cobra.Command{
+	Use:   "src",
+	Short: "Browse repository files without a clone",
+}
+
+func init() {
+	bitbucketCmd.AddCommand(bbSrcCmd)
+}
+
+// addBBSrcFlags adds the flags shared by the src subcommands.
+func addBBSrcFlags(c *cobra.Command) {
+	c.Flags().String("workspace", "", "Workspace slug")
+	c.Flags().String("repo", "", "Repository slug (required)")
+	c.MarkFlagRequired("repo")
+	c.Flags().String("ref", "", "Branch, tag or commit to read (default: main branch)")
+}
+
+// resolveSrcCommit resolves --ref to a commit hash, so that every page of
+// a listing is read from the same commit even if the branch moves.
+func resolveSrcCommit(client *bitbucket.Client, workspace, repo, ref string) (string, error) {
+	if ref == "" {
+		r, err := client.GetRepository(workspace, repo)
+		if err != nil {
+			return "", err
+		}
+		if r.MainBranch == nil {
+			return "", fmt.Errorf("repository %s has no main branch; pass --ref", repo)
+		}
+		ref = r.MainBranch.Name
+	}
+	commit, err := client.GetCommit(workspace, repo, ref)
+	if err != nil {
+		return "", fmt.Errorf("resolving ref %q: %w", ref, err)
+	}
+	return commit.Hash, nil
+}
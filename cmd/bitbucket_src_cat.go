@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+var bbSrcCatCmd = &cobra.Command{
+	Use:   "cat <path>",
+	Short: "Print a file from a repository",
+	Long: "Stream the raw content of a file as of --ref to stdout, or with --output " +
+		"to a local file. Binary files are written unchanged.",
+	Args: cobra.ExactArgs(1),
+	RunE: runBBSrcCat,
+}
+
+func init() {
+	addBBSrcFlags(bbSrcCatCmd)
+	bbSrcCatCmd.Flags().StringP("output", "o", "", "Write to this file instead of stdout")
+	bbSrcCmd.AddCommand(bbSrcCatCmd)
+}
+
+func runBBSrcCat(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+	ref, _ := cmd.Flags().GetString("ref")
+	output, _ := cmd.Flags().GetString("output")
+	path := args[0]
+
+	commit, err := resolveSrcCommit(client, workspace, repo, ref)
+	if err != nil {
+		return err
+	}
+
+	if output == "" {
+		return client.DownloadSrc(workspace, repo, commit, path, os.Stdout)
+	}
+
+	f, err := os.Create(output)
+	if err != nil {
+		return fmt.Errorf("creating %s: %w", output, err)
+	}
+	if err := client.DownloadSrc(workspace, repo, commit, path, f); err != nil {
+		f.Close()
+		os.Remove(output)
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("writing %s: %w", output, err)
+	}
+
+	if jsonMode(cmd) {
+		return printJSON(JSONMutationResult{Key: output})
+	}
+	fmt.Fprintf(os.Stderr, "Wrote %s to %s\n", path, output)
+	return nil
+}
@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var bbSrcHistoryCmd = &cobra.Command{
+	Use:   "history <path>",
+	Short: "Show the commits that changed a file",
+	Long: "List the commits that changed a file, newest first, as of --ref. Renames " +
+		"are followed; the path each revision had is shown when it differs.",
+	Args: cobra.ExactArgs(1),
+	RunE: runBBSrcHistory,
+}
+
+func init() {
+	addBBSrcFlags(bbSrcHistoryCmd)
+	bbSrcHistoryCmd.Flags().Int("max", 25, "Maximum number of results")
+	bbSrcHistoryCmd.Flags().Bool("all", false, "Return all results, ignoring --max")
+	bbSrcCmd.AddCommand(bbSrcHistoryCmd)
+}
+
+func runBBSrcHistory(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+	ref, _ := cmd.Flags().GetString("ref")
+	max, _ := cmd.Flags().GetInt("max")
+	all, _ := cmd.Flags().GetBool("all")
+	path := strings.Trim(args[0], "/")
+
+	if all {
+		max = 0
+	}
+	commit, err := resolveSrcCommit(client, workspace, repo, ref)
+	if err != nil {
+		return err
+	}
+	revisions, err := client.ListFileHistory(workspace, repo, commit, path, max)
+	if err != nil {
+		return err
+	}
+
+	items := make([]JSONFileRevision, 0, len(revisions))
+	for _, r := range revisions {
+		if r.Commit == nil {
+			continue
+		}
+		items = append(items, JSONFileRevision{
+			Commit:  r.Commit.Hash,
+			Path:    r.Path,
+			Author:  commitAuthorName(r.Commit.Author),
+			Date:    r.Commit.Date,
+			Message: strings.TrimSpace(r.Commit.Message),
+			URL:     bbCommitURL(workspace, repo, r.Commit.Hash),
+		})
+	}
+
+	if jsonMode(cmd) {
+		return printJSON(items)
+	}
+
+	if len(items) == 0 {
+		fmt.Println("No history found.")
+		return nil
+	}
+
+	fmt.Printf("Found %d revision(s) of %s:\n\n", len(items), path)
+	for _, r := range items {
+		subject, _, _ := strings.Cut(r.Message, "\n")
+		line := fmt.Sprintf("%-12s  %-10s  %-20s  %s", shortHash(r.Commit), dateOnly(r.Date), r.Author, subject)
+		if r.Path != path {
+			line += " (as " + r.Path + ")"
+		}
+		fmt.Println(line)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var bbSrcLsCmd = &cobra.Command{
+	Use:   "ls [path]",
+	Short: "List files in a repository directory",
+	Long:  "List the files and directories at path (default: the repository root) as of --ref.",
+	Args:  cobra.MaximumNArgs(1),
+	RunE:  runBBSrcLs,
+}
+
+func init() {
+	addBBSrcFlags(bbSrcLsCmd)
+	bbSrcLsCmd.Flags().Int("max", 0, "Maximum number of entries (0 for all)")
+	bbSrcCmd.AddCommand(bbSrcLsCmd)
+}
+
+func runBBSrcLs(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+	ref, _ := cmd.Flags().GetString("ref")
+	max, _ := cmd.Flags().GetInt("max")
+	path := ""
+	if len(args) > 0 {
+		path = args[0]
+	}
+
+	commit, err := resolveSrcCommit(client, workspace, repo, ref)
+	if err != nil {
+		return err
+	}
+	entries, err := client.ListSrc(workspace, repo, commit, path, max)
+	if err != nil {
+		return err
+	}
+
+	items := make([]JSONSrcEntry, len(entries))
+	for i, e := range entries {
+		items[i] = JSONSrcEntry{Path: e.Path, Type: "file", Size: e.Size}
+		if e.Type == "commit_directory" {
+			items[i].Type = "directory"
+		}
+		if e.Commit != nil {
+			items[i].Commit = e.Commit.Hash
+		}
+	}
+
+	if jsonMode(cmd) {
+		return printJSON(items)
+	}
+
+	if len(items) == 0 {
+		fmt.Println("No files found.")
+		return nil
+	}
+
+	for _, e := range items {
+		if e.Type == "directory" {
+			fmt.Printf("%10s  %s/\n", "-", e.Path)
+		} else {
+			fmt.Printf("%10s  %s\n", formatBytes(e.Size), e.Path)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/novshi-tech/atl-cli/internal/bitbucket"
+	"github.com/spf13/cobra"
+)
+
+var bbStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Report and query commit build statuses",
+}
+
+func init() {
+	bitbucketCmd.AddCommand(bbStatusCmd)
+}
+
+// checkStatusesGreen returns an error unless at least one status was
+// reported and all of them are SUCCESSFUL. A commit without statuses isn't
+// considered green, since its builds may simply not have reported yet.
+func checkStatusesGreen(statuses []bitbucket.CommitStatus) error {
+	if len(statuses) == 0 {
+		return fmt.Errorf("no build statuses reported")
+	}
+	var bad []string
+	for _, s := range statuses {
+		if s.State != "SUCCESSFUL" {
+			bad = append(bad, fmt.Sprintf("%s is %s", statusLabel(s), s.State))
+		}
+	}
+	if len(bad) > 0 {
+		return fmt.Errorf("builds not green: %s", strings.Join(bad, "; "))
+	}
+	return nil
+}
+
+func statusLabel(s bitbucket.CommitStatus) string {
+	if s.Name != "" {
+		return fmt.Sprintf("%q", s.Name)
+	}
+	return fmt.Sprintf("%q", s.Key)
+}
@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/novshi-tech/atl-cli/internal/bitbucket"
+	"github.com/spf13/cobra"
+)
+
+var bbStatusListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List build statuses of a commit or pull request",
+	RunE:  runBBStatusList,
+}
+
+func init() {
+	bbStatusListCmd.Flags().String("workspace", "", "Workspace slug")
+	bbStatusListCmd.Flags().String("repo", "", "Repository slug (required)")
+	bbStatusListCmd.MarkFlagRequired("repo")
+	bbStatusListCmd.Flags().String("commit", "", "Commit hash")
+	bbStatusListCmd.Flags().Int("pr", 0, "Pull request ID")
+	bbStatusListCmd.MarkFlagsMutuallyExclusive("commit", "pr")
+	bbStatusListCmd.MarkFlagsOneRequired("commit", "pr")
+	bbStatusCmd.AddCommand(bbStatusListCmd)
+}
+
+func runBBStatusList(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+	commit, _ := cmd.Flags().GetString("commit")
+	prID, _ := cmd.Flags().GetInt("pr")
+
+	var statuses []bitbucket.CommitStatus
+	if commit != "" {
+		statuses, err = client.ListCommitStatuses(workspace, repo, commit)
+	} else {
+		statuses, err = client.ListPRStatuses(workspace, repo, prID)
+	}
+	if err != nil {
+		return err
+	}
+
+	if jsonMode(cmd) {
+		items := make([]JSONCommitStatus, len(statuses))
+		for i, s := range statuses {
+			items[i] = toJSONCommitStatus(s)
+		}
+		return printJSON(items)
+	}
+
+	if len(statuses) == 0 {
+		fmt.Println("No statuses found.")
+		return nil
+	}
+
+	fmt.Printf("Found %d status(es):\n\n", len(statuses))
+	for _, s := range statuses {
+		name := s.Name
+		if name == "" {
+			name = s.Key
+		}
+		fmt.Printf("%-11s  %-30s  %s  %s\n", s.State, name, s.UpdatedOn, s.URL)
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/novshi-tech/atl-cli/internal/bitbucket"
+	"github.com/spf13/cobra"
+)
+
+var bbStatusSetCmd = &cobra.Command{
+	Use:   "set",
+	Short: "Report a build status on a commit",
+	Long: "Report a build status on a commit. Statuses are identified by --key: " +
+		"reporting the same key again (e.g. INPROGRESS, then SUCCESSFUL) updates it.",
+	RunE: runBBStatusSet,
+}
+
+func init() {
+	bbStatusSetCmd.Flags().String("workspace", "", "Workspace slug")
+	bbStatusSetCmd.Flags().String("repo", "", "Repository slug (required)")
+	bbStatusSetCmd.MarkFlagRequired("repo")
+	bbStatusSetCmd.Flags().String("commit", "", "Commit hash (required)")
+	bbStatusSetCmd.MarkFlagRequired("commit")
+	bbStatusSetCmd.Flags().String("key", "", "Status key, unique per build system (required)")
+	bbStatusSetCmd.MarkFlagRequired("key")
+	bbStatusSetCmd.Flags().String("state", "", "State: "+strings.Join(bitbucket.CommitStatusStates, ", ")+" (required)")
+	bbStatusSetCmd.MarkFlagRequired("state")
+	bbStatusSetCmd.Flags().String("url", "", "Link to the build (required)")
+	bbStatusSetCmd.MarkFlagRequired("url")
+	bbStatusSetCmd.Flags().String("name", "", "Display name of the build")
+	bbStatusSetCmd.Flags().StringP("description", "d", "", "Description of the build result")
+	bbStatusCmd.AddCommand(bbStatusSetCmd)
+}
+
+func runBBStatusSet(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+	commit, _ := cmd.Flags().GetString("commit")
+	key, _ := cmd.Flags().GetString("key")
+	state, _ := cmd.Flags().GetString("state")
+	buildURL, _ := cmd.Flags().GetString("url")
+	name, _ := cmd.Flags().GetString("name")
+	description, _ := cmd.Flags().GetString("description")
+
+	state = strings.ToUpper(state)
+	if !slices.Contains(bitbucket.CommitStatusStates, state) {
+		return fmt.Errorf("invalid --state %q; must be one of: %s", state, strings.Join(bitbucket.CommitStatusStates, ", "))
+	}
+
+	status, err := client.SetCommitStatus(workspace, repo, commit, bitbucket.SetCommitStatusRequest{
+		Key:         key,
+		State:       state,
+		Name:        name,
+		URL:         buildURL,
+		Description: description,
+	})
+	if err != nil {
+		return err
+	}
+
+	if jsonMode(cmd) {
+		return printJSON(toJSONCommitStatus(*status))
+	}
+
+	fmt.Printf("Set status %s to %s on %s\n", key, state, shortHash(commit))
+	return nil
+}
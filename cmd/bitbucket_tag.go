@@ -0,0 +1,12 @@
+package cmd
+
+import "github.com/spf13/cobra"
+
+var bbTagCmd = &cobra.Command{
+	Use:   "tag",
+	Short: "Manage repository tags",
+}
+
+func init() {
+	bitbucketCmd.AddCommand(bbTagCmd)
+}
@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var bbTagCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a tag",
+	Long: "Create a tag on --target, a commit hash or branch name. With --message " +
+		"the tag is annotated.",
+	RunE: runBBTagCreate,
+}
+
+func init() {
+	bbTagCreateCmd.Flags().String("workspace", "", "Workspace slug")
+	bbTagCreateCmd.Flags().String("repo", "", "Repository slug (required)")
+	bbTagCreateCmd.MarkFlagRequired("repo")
+	bbTagCreateCmd.Flags().String("name", "", "Tag name (required)")
+	bbTagCreateCmd.MarkFlagRequired("name")
+	bbTagCreateCmd.Flags().String("target", "", "Commit hash or branch to tag (required)")
+	bbTagCreateCmd.MarkFlagRequired("target")
+	bbTagCreateCmd.Flags().StringP("message", "m", "", "Tag message (creates an annotated tag)")
+	bbTagCmd.AddCommand(bbTagCreateCmd)
+}
+
+func runBBTagCreate(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+	name, _ := cmd.Flags().GetString("name")
+	target, _ := cmd.Flags().GetString("target")
+	message, _ := cmd.Flags().GetString("message")
+
+	ref, err := client.CreateTag(workspace, repo, name, target, message)
+	if err != nil {
+		return err
+	}
+
+	url := bbRefURL(workspace, repo, "tag", ref.Name)
+	if jsonMode(cmd) {
+		return printJSON(JSONMutationResult{Key: ref.Name, URL: url})
+	}
+
+	fmt.Printf("Created tag %s at %s\n", ref.Name, shortHash(ref.Target.Hash))
+	fmt.Printf("URL: %s\n", url)
+	return nil
+}
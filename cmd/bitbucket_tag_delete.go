@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var bbTagDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete a tag",
+	RunE:  runBBTagDelete,
+}
+
+func init() {
+	bbTagDeleteCmd.Flags().String("workspace", "", "Workspace slug")
+	bbTagDeleteCmd.Flags().String("repo", "", "Repository slug (required)")
+	bbTagDeleteCmd.MarkFlagRequired("repo")
+	bbTagDeleteCmd.Flags().String("name", "", "Tag name (required)")
+	bbTagDeleteCmd.MarkFlagRequired("name")
+	bbTagDeleteCmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+	bbTagCmd.AddCommand(bbTagDeleteCmd)
+}
+
+func runBBTagDelete(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+	name, _ := cmd.Flags().GetString("name")
+
+	if err := confirmDestructive(cmd, fmt.Sprintf("Delete tag %s in %s?", name, repo)); err != nil {
+		return err
+	}
+	if err := client.DeleteTag(workspace, repo, name); err != nil {
+		return err
+	}
+
+	if jsonMode(cmd) {
+		return printJSON(JSONMutationResult{Key: name})
+	}
+
+	fmt.Printf("Deleted tag %s\n", name)
+	return nil
+}
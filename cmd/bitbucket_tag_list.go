@@ -0,0 +1,18 @@
+package cmd
+
+import "github.com/spf13/cobra"
+
+var bbTagListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List tags in a repository",
+	Long: "List tags in a repository. --filter matches names containing the given text, or a " +
+		"glob such as release/* that must match the whole name.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runBBRefList(cmd, "tag")
+	},
+}
+
+func init() {
+	addBBRefListFlags(bbTagListCmd)
+	bbTagCmd.AddCommand(bbTagListCmd)
+}
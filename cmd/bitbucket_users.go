@@ -0,0 +1,24 @@
+package cmd
+
+import (
+	"github.com/novshi-tech/atl-cli/internal/bitbucket"
+)
+
+// resolveBBUserUUIDs is resolveBBUserUUID for several references. Answers
+// are remembered in cache, so that a caller working through many
+// repositories resolves each user once.
+func resolveBBUserUUIDs(client *bitbucket.Client, workspace string, refs []string, cache map[string]string) ([]string, error) {
+	uuids := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		uuid, ok := cache[ref]
+		if !ok {
+			var err error
+			if uuid, err = resolveBBUserUUID(client, workspace, ref); err != nil {
+				return nil, err
+			}
+			cache[ref] = uuid
+		}
+		uuids = append(uuids, uuid)
+	}
+	return uuids, nil
+}
@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/novshi-tech/atl-cli/internal/bitbucket"
+	"github.com/spf13/cobra"
+)
+
+var bbWebhookCmd = &cobra.Command{
+	Use:   "webhook",
+	Short: "Manage repository and workspace webhooks",
+	Long: "Manage webhooks. The commands act on the webhooks of --repo, or with " +
+		"--workspace-level on the workspace's own webhooks, which fire for every " +
+		"repository in it; one of the two is required. Bitbucket's API does not " +
+		"expose webhook delivery history; use the \"View requests\" page in the " +
+		"web UI.",
+}
+
+func init() {
+	bitbucketCmd.AddCommand(bbWebhookCmd)
+}
+
+// addBBWebhookScopeFlags adds the flags choosing whose webhooks a command
+// acts on: a repository's, or the workspace's with --workspace-level.
+func addBBWebhookScopeFlags(c *cobra.Command) {
+	c.Flags().String("workspace", "", "Workspace slug")
+	c.Flags().String("repo", "", "Repository slug (required unless --workspace-level)")
+	c.Flags().Bool("workspace-level", false, "Act on the workspace's own webhooks, which fire for every repository")
+	c.MarkFlagsOneRequired("repo", "workspace-level")
+	c.MarkFlagsMutuallyExclusive("repo", "workspace-level")
+}
+
+// addBBWebhookSettingFlags adds the flags shared by webhook create and update.
+func addBBWebhookSettingFlags(c *cobra.Command) {
+	c.Flags().String("url", "", "URL the webhook posts events to")
+	c.Flags().StringSlice("events", nil, "Events to subscribe to, e.g. repo:push,pullrequest:created (see 'webhook events')")
+	c.Flags().StringP("description", "d", "", "Webhook title")
+	c.Flags().Bool("secret", false, "Sign payloads with a secret read from stdin (prompted for without echo on a terminal)")
+	c.Flags().Bool("active", true, "Deliver events (--active=false to pause the webhook)")
+	c.Flags().Bool("skip-cert-verification", false, "Don't verify the TLS certificate of the URL")
+}
+
+// applyWebhookSettingFlags copies the webhook settings given on the command
+// line onto req, leaving the others unchanged.
+func applyWebhookSettingFlags(cmd *cobra.Command, req *bitbucket.WebhookRequest) error {
+	if cmd.Flags().Changed("url") {
+		req.URL, _ = cmd.Flags().GetString("url")
+	}
+	if cmd.Flags().Changed("events") {
+		req.Events, _ = cmd.Flags().GetStringSlice("events")
+	}
+	if cmd.Flags().Changed("description") {
+		req.Description, _ = cmd.Flags().GetString("description")
+	}
+	if cmd.Flags().Changed("active") {
+		req.Active, _ = cmd.Flags().GetBool("active")
+	}
+	if cmd.Flags().Changed("skip-cert-verification") {
+		req.SkipCertVerification, _ = cmd.Flags().GetBool("skip-cert-verification")
+	}
+	if useSecret, _ := cmd.Flags().GetBool("secret"); useSecret {
+		secret, err := readVariableValue("webhook secret")
+		if err != nil {
+			return err
+		}
+		req.Secret = &secret
+	}
+	return nil
+}
+
+func toJSONWebhook(h bitbucket.Webhook) JSONWebhook {
+	events := h.Events
+	if events == nil {
+		events = []string{}
+	}
+	return JSONWebhook{
+		UUID:        h.UUID,
+		URL:         h.URL,
+		Description: h.Description,
+		SubjectType: h.SubjectType,
+		Active:      h.Active,
+		Events:      events,
+		SecretSet:   h.SecretSet,
+		CreatedAt:   h.CreatedAt,
+	}
+}
+
+// webhookScope describes where a webhook lives, for messages.
+func webhookScope(workspace, repo string) string {
+	if repo == "" {
+		return "workspace " + workspace
+	}
+	return workspace + "/" + repo
+}
+
+func printWebhook(h bitbucket.Webhook) {
+	fmt.Printf("UUID:         %s\n", h.UUID)
+	fmt.Printf("Description:  %s\n", h.Description)
+	fmt.Printf("URL:          %s\n", h.URL)
+	fmt.Printf("Events:       %s\n", strings.Join(h.Events, ", "))
+	active := "No"
+	if h.Active {
+		active = "Yes"
+	}
+	fmt.Printf("Active:       %s\n", active)
+	secret := "No"
+	if h.SecretSet {
+		secret = "Yes"
+	}
+	fmt.Printf("Secret:       %s\n", secret)
+}
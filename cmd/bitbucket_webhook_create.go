@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/novshi-tech/atl-cli/internal/bitbucket"
+	"github.com/spf13/cobra"
+)
+
+var bbWebhookCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a webhook",
+	Long: "Create a webhook on a repository, or with --workspace-level, on the " +
+		"workspace. The description defaults to the URL.",
+	RunE: runBBWebhookCreate,
+}
+
+func init() {
+	addBBWebhookScopeFlags(bbWebhookCreateCmd)
+	addBBWebhookSettingFlags(bbWebhookCreateCmd)
+	bbWebhookCreateCmd.MarkFlagRequired("url")
+	bbWebhookCreateCmd.MarkFlagRequired("events")
+	bbWebhookCmd.AddCommand(bbWebhookCreateCmd)
+}
+
+func runBBWebhookCreate(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+
+	req := bitbucket.WebhookRequest{Active: true}
+	if err := applyWebhookSettingFlags(cmd, &req); err != nil {
+		return err
+	}
+	if req.Description == "" {
+		req.Description = req.URL
+	}
+
+	h, err := client.CreateWebhook(workspace, repo, req)
+	if err != nil {
+		return err
+	}
+
+	if jsonMode(cmd) {
+		return printJSON(toJSONWebhook(*h))
+	}
+
+	fmt.Printf("Created webhook on %s\n\n", webhookScope(workspace, repo))
+	printWebhook(*h)
+	return nil
+}
@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var bbWebhookDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete a webhook",
+	RunE:  runBBWebhookDelete,
+}
+
+func init() {
+	addBBWebhookScopeFlags(bbWebhookDeleteCmd)
+	bbWebhookDeleteCmd.Flags().String("id", "", "Webhook UUID (required)")
+	bbWebhookDeleteCmd.MarkFlagRequired("id")
+	bbWebhookDeleteCmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+	bbWebhookCmd.AddCommand(bbWebhookDeleteCmd)
+}
+
+func runBBWebhookDelete(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+	id, _ := cmd.Flags().GetString("id")
+
+	if err := confirmDestructive(cmd, fmt.Sprintf("Delete webhook %s on %s?", id, webhookScope(workspace, repo))); err != nil {
+		return err
+	}
+	if err := client.DeleteWebhook(workspace, repo, id); err != nil {
+		return err
+	}
+
+	if jsonMode(cmd) {
+		return printJSON(JSONMutationResult{Key: id})
+	}
+
+	fmt.Printf("Deleted webhook %s\n", id)
+	return nil
+}
@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var bbWebhookEventsCmd = &cobra.Command{
+	Use:   "events",
+	Short: "List the events a webhook can subscribe to",
+	RunE:  runBBWebhookEvents,
+}
+
+func init() {
+	bbWebhookEventsCmd.Flags().Bool("workspace-level", false, "List the events of workspace webhooks instead of repository webhooks")
+	bbWebhookCmd.AddCommand(bbWebhookEventsCmd)
+}
+
+func runBBWebhookEvents(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	subject := "repository"
+	if ws, _ := cmd.Flags().GetBool("workspace-level"); ws {
+		subject = "workspace"
+	}
+
+	events, err := client.ListHookEvents(subject)
+	if err != nil {
+		return err
+	}
+
+	if jsonMode(cmd) {
+		items := make([]JSONHookEvent, len(events))
+		for i, e := range events {
+			items[i] = JSONHookEvent{Event: e.Event, Category: e.Category, Description: e.Description}
+		}
+		return printJSON(items)
+	}
+
+	for _, e := range events {
+		fmt.Printf("%-36s  %s\n", e.Event, e.Description)
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var bbWebhookListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List webhooks",
+	RunE:  runBBWebhookList,
+}
+
+func init() {
+	addBBWebhookScopeFlags(bbWebhookListCmd)
+	bbWebhookCmd.AddCommand(bbWebhookListCmd)
+}
+
+func runBBWebhookList(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+
+	hooks, err := client.ListWebhooks(workspace, repo)
+	if err != nil {
+		return err
+	}
+
+	if jsonMode(cmd) {
+		items := make([]JSONWebhook, len(hooks))
+		for i, h := range hooks {
+			items[i] = toJSONWebhook(h)
+		}
+		return printJSON(items)
+	}
+
+	if len(hooks) == 0 {
+		fmt.Println("No webhooks found.")
+		return nil
+	}
+
+	fmt.Printf("Found %d webhook(s):\n\n", len(hooks))
+	for _, h := range hooks {
+		state := "active"
+		if !h.Active {
+			state = "inactive"
+		}
+		fmt.Printf("%s  %-8s  %s\n", h.UUID, state, h.Description)
+		fmt.Printf("  URL: %s\n", h.URL)
+		fmt.Printf("  Events: %s\n", strings.Join(h.Events, ", "))
+	}
+	return nil
+}
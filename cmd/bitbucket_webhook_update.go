@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/novshi-tech/atl-cli/internal/bitbucket"
+	"github.com/spf13/cobra"
+)
+
+var bbWebhookUpdateCmd = &cobra.Command{
+	Use:   "update",
+	Short: "Update a webhook",
+	Long: "Update the given settings of a webhook, keeping the others. --events " +
+		"replaces the whole list of events.",
+	RunE: runBBWebhookUpdate,
+}
+
+func init() {
+	addBBWebhookScopeFlags(bbWebhookUpdateCmd)
+	bbWebhookUpdateCmd.Flags().String("id", "", "Webhook UUID (required)")
+	bbWebhookUpdateCmd.MarkFlagRequired("id")
+	addBBWebhookSettingFlags(bbWebhookUpdateCmd)
+	bbWebhookCmd.AddCommand(bbWebhookUpdateCmd)
+}
+
+func runBBWebhookUpdate(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	repo, _ := cmd.Flags().GetString("repo")
+	id, _ := cmd.Flags().GetString("id")
+
+	current, err := client.GetWebhook(workspace, repo, id)
+	if err != nil {
+		return err
+	}
+	req := bitbucket.WebhookRequest{
+		URL:                  current.URL,
+		Description:          current.Description,
+		Active:               current.Active,
+		Events:               current.Events,
+		SkipCertVerification: current.SkipCertVerification,
+	}
+	if err := applyWebhookSettingFlags(cmd, &req); err != nil {
+		return err
+	}
+
+	h, err := client.UpdateWebhook(workspace, repo, id, req)
+	if err != nil {
+		return err
+	}
+
+	if jsonMode(cmd) {
+		return printJSON(toJSONWebhook(*h))
+	}
+
+	fmt.Printf("Updated webhook on %s\n\n", webhookScope(workspace, repo))
+	printWebhook(*h)
+	return nil
+}
@@ -0,0 +1,14 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var bbWorkspaceCmd = &cobra.Command{
+	Use:   "workspace",
+	Short: "Inspect Bitbucket workspaces",
+}
+
+func init() {
+	bitbucketCmd.AddCommand(bbWorkspaceCmd)
+}
@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var bbWorkspaceListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the workspaces you belong to",
+	RunE:  runBBWorkspaceList,
+}
+
+func init() {
+	bbWorkspaceCmd.AddCommand(bbWorkspaceListCmd)
+}
+
+func runBBWorkspaceList(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspaces, err := client.ListWorkspaces()
+	if err != nil {
+		return err
+	}
+
+	if jsonMode(cmd) {
+		items := make([]JSONWorkspace, len(workspaces))
+		for i, w := range workspaces {
+			items[i] = JSONWorkspace{
+				Slug:          w.Workspace.Slug,
+				Name:          w.Workspace.Name,
+				UUID:          w.Workspace.UUID,
+				Administrator: w.Administrator,
+			}
+		}
+		return printJSON(items)
+	}
+
+	if len(workspaces) == 0 {
+		fmt.Println("No workspaces found.")
+		return nil
+	}
+
+	fmt.Printf("Found %d workspace(s):\n\n", len(workspaces))
+	for _, w := range workspaces {
+		role := "member"
+		if w.Administrator {
+			role = "admin"
+		}
+		fmt.Printf("%-30s  %-30s  %s\n", w.Workspace.Slug, w.Workspace.Name, role)
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var bbWorkspaceMembersCmd = &cobra.Command{
+	Use:   "members",
+	Short: "List the members of a workspace",
+	RunE:  runBBWorkspaceMembers,
+}
+
+func init() {
+	bbWorkspaceMembersCmd.Flags().String("workspace", "", "Workspace slug")
+	bbWorkspaceMembersCmd.Flags().StringP("query", "q", "", "Only members whose display name or nickname contains this text")
+	bbWorkspaceCmd.AddCommand(bbWorkspaceMembersCmd)
+}
+
+func runBBWorkspaceMembers(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+	query, _ := cmd.Flags().GetString("query")
+	query = strings.ToLower(query)
+
+	members, err := client.ListWorkspaceMembers(workspace)
+	if err != nil {
+		return err
+	}
+
+	items := []JSONWorkspaceMember{}
+	for _, m := range members {
+		if query != "" && !strings.Contains(strings.ToLower(m.User.DisplayName), query) &&
+			!strings.Contains(strings.ToLower(m.User.Nickname), query) {
+			continue
+		}
+		items = append(items, JSONWorkspaceMember{
+			DisplayName: m.User.DisplayName,
+			Nickname:    m.User.Nickname,
+			AccountID:   m.User.AccountID,
+			UUID:        m.User.UUID,
+		})
+	}
+
+	if jsonMode(cmd) {
+		return printJSON(items)
+	}
+
+	if len(items) == 0 {
+		fmt.Println("No members found.")
+		return nil
+	}
+
+	fmt.Printf("Found %d member(s):\n\n", len(items))
+	for _, m := range items {
+		fmt.Printf("%-30s  %-20s  %s\n", m.DisplayName, m.Nickname, m.AccountID)
+	}
+	return nil
+}
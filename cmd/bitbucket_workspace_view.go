@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var bbWorkspaceViewCmd = &cobra.Command{
+	Use:   "view",
+	Short: "Show details of a workspace",
+	RunE:  runBBWorkspaceView,
+}
+
+func init() {
+	bbWorkspaceViewCmd.Flags().String("workspace", "", "Workspace slug")
+	bbWorkspaceCmd.AddCommand(bbWorkspaceViewCmd)
+}
+
+func runBBWorkspaceView(cmd *cobra.Command, args []string) error {
+	client, err := newBitbucketClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	workspace, err := resolveBBWorkspace(cmd)
+	if err != nil {
+		return err
+	}
+
+	w, err := client.GetWorkspace(workspace)
+	if err != nil {
+		return err
+	}
+
+	if jsonMode(cmd) {
+		return printJSON(JSONWorkspaceDetail{
+			Slug:      w.Slug,
+			Name:      w.Name,
+			UUID:      w.UUID,
+			IsPrivate: w.IsPrivate,
+			CreatedOn: w.CreatedOn,
+			URL:       w.Links.HTML.Href,
+		})
+	}
+
+	fmt.Printf("Slug:      %s\n", w.Slug)
+	fmt.Printf("Name:      %s\n", w.Name)
+	fmt.Printf("UUID:      %s\n", w.UUID)
+	private := "No"
+	if w.IsPrivate {
+		private = "Yes"
+	}
+	fmt.Printf("Private:   %s\n", private)
+	fmt.Printf("Created:   %s\n", w.CreatedOn)
+	fmt.Printf("URL:       %s\n", w.Links.HTML.Href)
+	return nil
+}
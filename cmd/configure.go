@@ -4,9 +4,11 @@ import (
 	"bufio"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/novshi-tech/atl-cli/internal/auth"
+	"github.com/novshi-tech/atl-cli/internal/bitbucket"
 	"github.com/spf13/cobra"
 )
 
@@ -43,15 +45,14 @@ func runConfigure(cmd *cobra.Command, args []string) error {
 	email := promptText(reader, "Email", existing.Email)
 	apiToken := promptSecret("API Token", existing.APIToken)
 	bbAPIToken := promptSecret("Bitbucket API Token", existing.BBAPIToken)
-	bbWorkspace := promptText(reader, "Bitbucket Workspace", existing.BBWorkspace)
 
 	creds := auth.SiteCredentials{
-		BaseURL:     siteURL,
-		Email:       email,
-		APIToken:    apiToken,
-		BBAPIToken:  bbAPIToken,
-		BBWorkspace: bbWorkspace,
+		BaseURL:    siteURL,
+		Email:      email,
+		APIToken:   apiToken,
+		BBAPIToken: bbAPIToken,
 	}
+	creds.BBWorkspace = promptWorkspace(reader, creds, existing.BBWorkspace)
 
 	if err := auth.SaveSite(store, alias, creds); err != nil {
 		return fmt.Errorf("saving credentials: %w", err)
@@ -96,6 +97,46 @@ func promptText(reader *bufio.Reader, label, current string) string {
 	return input
 }
 
+// promptWorkspace prompts for the Bitbucket workspace, offering the
+// workspaces the credentials can access as a numbered list. The user may
+// enter a number or a slug; Enter keeps the current value. If the list
+// can't be fetched (e.g. no token yet), it falls back to free text.
+func promptWorkspace(reader *bufio.Reader, creds auth.SiteCredentials, current string) string {
+	if creds.Email == "" || (creds.APIToken == "" && creds.BBAPIToken == "") {
+		return promptText(reader, "Bitbucket Workspace", current)
+	}
+	workspaces, err := bitbucket.NewClient(creds).ListWorkspaces()
+	if err != nil || len(workspaces) == 0 {
+		if err != nil {
+			fmt.Printf("Could not list Bitbucket workspaces: %v\n", err)
+		}
+		return promptText(reader, "Bitbucket Workspace", current)
+	}
+
+	fmt.Println("Bitbucket workspaces:")
+	for i, w := range workspaces {
+		fmt.Printf("  %d) %s", i+1, w.Workspace.Slug)
+		if w.Workspace.Name != "" && w.Workspace.Name != w.Workspace.Slug {
+			fmt.Printf(" (%s)", w.Workspace.Name)
+		}
+		fmt.Println()
+	}
+	if current == "" && len(workspaces) == 1 {
+		current = workspaces[0].Workspace.Slug
+	}
+	for {
+		input := promptText(reader, "Bitbucket Workspace (number or slug)", current)
+		n, err := strconv.Atoi(input)
+		if err != nil {
+			return input
+		}
+		if n >= 1 && n <= len(workspaces) {
+			return workspaces[n-1].Workspace.Slug
+		}
+		fmt.Printf("Enter a number between 1 and %d, or a workspace slug.\n", len(workspaces))
+	}
+}
+
 // promptSecret prompts for a secret value (input hidden). If the user presses Enter, the existing value is kept.
 // The indicator "(parsed from clipboard)" or "(received)" appears immediately upon input, before Enter is pressed.
 func promptSecret(label, current string) string {
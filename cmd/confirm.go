@@ -0,0 +1,34 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+// confirmDestructive asks the user to approve an irreversible action
+// described by prompt. It returns nil if --yes was passed or the user
+// answers "y". Without --yes, a non-interactive stdin is refused outright
+// so that scripts neither hang on a prompt nobody sees nor proceed
+// without explicit consent.
+func confirmDestructive(cmd *cobra.Command, prompt string) error {
+	yes, _ := cmd.Flags().GetBool("yes")
+	if yes {
+		return nil
+	}
+	if !term.IsTerminal(int(os.Stdin.Fd())) {
+		return fmt.Errorf("confirmation required but stdin is not a terminal; pass --yes to proceed")
+	}
+
+	fmt.Fprintf(os.Stderr, "%s [y/N]: ", prompt)
+	input, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+	switch strings.ToLower(strings.TrimSpace(input)) {
+	case "y", "yes":
+		return nil
+	}
+	return fmt.Errorf("aborted")
+}
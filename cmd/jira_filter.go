@@ -0,0 +1,31 @@
+package cmd
+
+import (
+	"github.com/novshi-tech/atl-cli/internal/jira"
+	"github.com/spf13/cobra"
+)
+
+var filterCmd = &cobra.Command{
+	Use:   "filter",
+	Short: "Manage saved Jira filters",
+}
+
+func init() {
+	jiraCmd.AddCommand(filterCmd)
+}
+
+func toJSONFilterItem(f *jira.Filter) JSONFilterItem {
+	owner := ""
+	if f.Owner != nil {
+		owner = f.Owner.DisplayName
+	}
+	return JSONFilterItem{
+		ID:          f.ID,
+		Name:        f.Name,
+		JQL:         f.JQL,
+		Description: f.Description,
+		Owner:       owner,
+		Favourite:   f.Favourite,
+		URL:         f.ViewURL,
+	}
+}
@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/novshi-tech/atl-cli/internal/jira"
+	"github.com/spf13/cobra"
+)
+
+var filterCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a saved filter",
+	RunE:  runFilterCreate,
+}
+
+func init() {
+	filterCreateCmd.Flags().String("name", "", "Filter name (required)")
+	filterCreateCmd.MarkFlagRequired("name")
+	filterCreateCmd.Flags().String("jql", "", "JQL query (required)")
+	filterCreateCmd.MarkFlagRequired("jql")
+	filterCreateCmd.Flags().StringP("description", "d", "", "Filter description")
+	filterCreateCmd.Flags().Bool("favourite", false, "Add the filter to your favourites")
+	filterCmd.AddCommand(filterCreateCmd)
+}
+
+func runFilterCreate(cmd *cobra.Command, args []string) error {
+	client, err := newJiraClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	name, _ := cmd.Flags().GetString("name")
+	jql, _ := cmd.Flags().GetString("jql")
+	favourite, _ := cmd.Flags().GetBool("favourite")
+
+	req := jira.FilterRequest{
+		Name: name,
+		JQL:  jql,
+	}
+	if cmd.Flags().Changed("description") {
+		description, _ := cmd.Flags().GetString("description")
+		req.Description = &description
+	}
+	if favourite {
+		req.Favourite = &favourite
+	}
+
+	f, err := client.CreateFilter(req)
+	if err != nil {
+		return err
+	}
+
+	if jsonMode(cmd) {
+		return printJSON(JSONMutationResult{Key: f.ID, URL: f.ViewURL})
+	}
+
+	fmt.Printf("Created filter: %s (%s)\n", f.Name, f.ID)
+	if f.ViewURL != "" {
+		fmt.Printf("URL: %s\n", f.ViewURL)
+	}
+	return nil
+}
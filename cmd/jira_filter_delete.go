@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var filterDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete a saved filter",
+	RunE:  runFilterDelete,
+}
+
+func init() {
+	filterDeleteCmd.Flags().String("filter", "", "Filter ID or exact name (required)")
+	filterDeleteCmd.MarkFlagRequired("filter")
+	filterDeleteCmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+	filterCmd.AddCommand(filterDeleteCmd)
+}
+
+func runFilterDelete(cmd *cobra.Command, args []string) error {
+	client, err := newJiraClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	nameOrID, _ := cmd.Flags().GetString("filter")
+
+	f, err := client.ResolveFilter(nameOrID)
+	if err != nil {
+		return err
+	}
+
+	if err := confirmDestructive(cmd, fmt.Sprintf("Delete filter %q (%s)?", f.Name, f.ID)); err != nil {
+		return err
+	}
+
+	if err := client.DeleteFilter(f.ID); err != nil {
+		return err
+	}
+
+	if jsonMode(cmd) {
+		return printJSON(JSONMutationResult{Key: f.ID, URL: f.ViewURL})
+	}
+
+	fmt.Printf("Deleted filter: %s (%s)\n", f.Name, f.ID)
+	return nil
+}
@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var filterFavouriteCmd = &cobra.Command{
+	Use:   "favourite",
+	Short: "Add a saved filter to (or remove it from) your favourites",
+	RunE:  runFilterFavourite,
+}
+
+func init() {
+	filterFavouriteCmd.Flags().String("filter", "", "Filter ID or exact name (required)")
+	filterFavouriteCmd.MarkFlagRequired("filter")
+	filterFavouriteCmd.Flags().Bool("remove", false, "Remove the filter from your favourites instead")
+	filterCmd.AddCommand(filterFavouriteCmd)
+}
+
+func runFilterFavourite(cmd *cobra.Command, args []string) error {
+	client, err := newJiraClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	nameOrID, _ := cmd.Flags().GetString("filter")
+	remove, _ := cmd.Flags().GetBool("remove")
+
+	f, err := client.ResolveFilter(nameOrID)
+	if err != nil {
+		return err
+	}
+
+	if err := client.SetFilterFavourite(f.ID, !remove); err != nil {
+		return err
+	}
+
+	if jsonMode(cmd) {
+		return printJSON(JSONMutationResult{Key: f.ID, URL: f.ViewURL})
+	}
+
+	if remove {
+		fmt.Printf("Removed filter %q (%s) from favourites\n", f.Name, f.ID)
+	} else {
+		fmt.Printf("Added filter %q (%s) to favourites\n", f.Name, f.ID)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/novshi-tech/atl-cli/internal/jira"
+	"github.com/spf13/cobra"
+)
+
+var filterListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List saved filters",
+	RunE:  runFilterList,
+}
+
+func init() {
+	filterListCmd.Flags().StringP("query", "q", "", "Filter by name (case-insensitive substring match)")
+	filterListCmd.Flags().Bool("favourites", false, "List only your favourite filters")
+	filterListCmd.Flags().Int("max", 50, "Maximum number of results")
+	filterListCmd.MarkFlagsMutuallyExclusive("query", "favourites")
+	filterCmd.AddCommand(filterListCmd)
+}
+
+func runFilterList(cmd *cobra.Command, args []string) error {
+	client, err := newJiraClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	query, _ := cmd.Flags().GetString("query")
+	favourites, _ := cmd.Flags().GetBool("favourites")
+	max, _ := cmd.Flags().GetInt("max")
+
+	var filters []jira.Filter
+	if favourites {
+		filters, err = client.GetFavouriteFilters()
+	} else {
+		var resp *jira.FilterSearchResponse
+		resp, err = client.SearchFilters(query, max)
+		if resp != nil {
+			filters = resp.Values
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	if jsonMode(cmd) {
+		items := make([]JSONFilterItem, len(filters))
+		for i := range filters {
+			items[i] = toJSONFilterItem(&filters[i])
+		}
+		return printJSON(items)
+	}
+
+	if len(filters) == 0 {
+		fmt.Println("No filters found.")
+		return nil
+	}
+
+	fmt.Printf("Found %d filter(s):\n\n", len(filters))
+	for _, f := range filters {
+		star := " "
+		if f.Favourite {
+			star = "*"
+		}
+		fmt.Printf("%-8s %s %-30s  %s\n", f.ID, star, f.Name, f.JQL)
+	}
+	return nil
+}
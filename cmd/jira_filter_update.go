@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/novshi-tech/atl-cli/internal/jira"
+	"github.com/spf13/cobra"
+)
+
+var filterUpdateCmd = &cobra.Command{
+	Use:   "update",
+	Short: "Update a saved filter",
+	RunE:  runFilterUpdate,
+}
+
+func init() {
+	filterUpdateCmd.Flags().String("filter", "", "Filter ID or exact name (required)")
+	filterUpdateCmd.MarkFlagRequired("filter")
+	filterUpdateCmd.Flags().String("name", "", "New filter name")
+	filterUpdateCmd.Flags().String("jql", "", "New JQL query")
+	filterUpdateCmd.Flags().StringP("description", "d", "", "New description")
+	filterCmd.AddCommand(filterUpdateCmd)
+}
+
+func runFilterUpdate(cmd *cobra.Command, args []string) error {
+	client, err := newJiraClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	nameOrID, _ := cmd.Flags().GetString("filter")
+
+	f, err := client.ResolveFilter(nameOrID)
+	if err != nil {
+		return err
+	}
+
+	// The update endpoint replaces name/jql/description wholesale, so start
+	// from the current values and overlay only the flags that were given.
+	req := jira.FilterRequest{
+		Name:        f.Name,
+		JQL:         f.JQL,
+		Description: &f.Description,
+	}
+	changed := false
+	if cmd.Flags().Changed("name") {
+		req.Name, _ = cmd.Flags().GetString("name")
+		changed = true
+	}
+	if cmd.Flags().Changed("jql") {
+		req.JQL, _ = cmd.Flags().GetString("jql")
+		changed = true
+	}
+	if cmd.Flags().Changed("description") {
+		description, _ := cmd.Flags().GetString("description")
+		req.Description = &description
+		changed = true
+	}
+
+	if !changed {
+		if jsonMode(cmd) {
+			return printJSON(JSONMutationResult{Key: f.ID, URL: f.ViewURL})
+		}
+		fmt.Println("Nothing to update. Specify --name, --jql, or --description.")
+		return nil
+	}
+
+	updated, err := client.UpdateFilter(f.ID, req)
+	if err != nil {
+		return err
+	}
+
+	if jsonMode(cmd) {
+		return printJSON(JSONMutationResult{Key: updated.ID, URL: updated.ViewURL})
+	}
+
+	fmt.Printf("Updated filter: %s (%s)\n", updated.Name, updated.ID)
+	if updated.ViewURL != "" {
+		fmt.Printf("URL: %s\n", updated.ViewURL)
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var filterViewCmd = &cobra.Command{
+	Use:   "view",
+	Short: "View a saved filter",
+	RunE:  runFilterView,
+}
+
+func init() {
+	filterViewCmd.Flags().String("filter", "", "Filter ID or exact name (required)")
+	filterViewCmd.MarkFlagRequired("filter")
+	filterCmd.AddCommand(filterViewCmd)
+}
+
+func runFilterView(cmd *cobra.Command, args []string) error {
+	client, err := newJiraClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	nameOrID, _ := cmd.Flags().GetString("filter")
+
+	f, err := client.ResolveFilter(nameOrID)
+	if err != nil {
+		return err
+	}
+
+	if jsonMode(cmd) {
+		return printJSON(toJSONFilterItem(f))
+	}
+
+	owner := "-"
+	if f.Owner != nil {
+		owner = f.Owner.DisplayName
+	}
+	favourite := "No"
+	if f.Favourite {
+		favourite = "Yes"
+	}
+	fmt.Printf("ID:           %s\n", f.ID)
+	fmt.Printf("Name:         %s\n", f.Name)
+	fmt.Printf("Owner:        %s\n", owner)
+	fmt.Printf("Favourite:    %s\n", favourite)
+	if f.Description != "" {
+		fmt.Printf("Description:  %s\n", f.Description)
+	}
+	fmt.Printf("JQL:          %s\n", f.JQL)
+	if f.ViewURL != "" {
+		fmt.Printf("URL:          %s\n", f.ViewURL)
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var issueArchiveCmd = &cobra.Command{
+	Use:   "archive",
+	Short: "Archive Jira issues",
+	Long: "Archive one or more Jira issues (Jira Premium/Enterprise only). " +
+		"Archived issues are hidden from search and boards but can be restored " +
+		"from the Jira UI. Confirmation is required; pass --yes to skip the prompt.",
+	RunE: runIssueArchive,
+}
+
+func init() {
+	issueArchiveCmd.Flags().StringSliceP("key", "k", nil, "Issue key(s) to archive (required; repeatable or comma-separated)")
+	issueArchiveCmd.MarkFlagRequired("key")
+	issueArchiveCmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+	issueCmd.AddCommand(issueArchiveCmd)
+}
+
+func runIssueArchive(cmd *cobra.Command, args []string) error {
+	client, err := newJiraClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	keys, _ := cmd.Flags().GetStringSlice("key")
+
+	fmt.Fprintf(os.Stderr, "The following will be archived:\n\n")
+	for _, key := range keys {
+		issue, err := client.GetIssue(key)
+		if err != nil {
+			return err
+		}
+		printIssueSummaryLine(issue.Key, issue.Fields.IssueType.Name, issue.Fields.Status.Name, issue.Fields.Summary)
+	}
+	fmt.Fprintln(os.Stderr)
+
+	if err := confirmDestructive(cmd, fmt.Sprintf("Archive %d issue(s)?", len(keys))); err != nil {
+		return err
+	}
+
+	resp, err := client.ArchiveIssues(keys)
+	if err != nil {
+		return err
+	}
+
+	failed := make(map[string]string)
+	for _, e := range resp.Errors {
+		for _, k := range e.IssueIDsOrKeys {
+			failed[k] = e.Message
+		}
+	}
+
+	if jsonMode(cmd) {
+		result := JSONIssueArchiveResult{Archived: resp.NumberOfIssuesUpdated}
+		for _, k := range keys {
+			if msg, ok := failed[k]; ok {
+				result.Failed = append(result.Failed, JSONIssueArchiveFailure{Key: k, Error: msg})
+			}
+		}
+		return printJSON(result)
+	}
+
+	fmt.Printf("Archived %d issue(s)\n", resp.NumberOfIssuesUpdated)
+	if len(failed) > 0 {
+		failedKeys := make([]string, 0, len(failed))
+		for k := range failed {
+			failedKeys = append(failedKeys, k)
+		}
+		sort.Strings(failedKeys)
+		fmt.Printf("Failed to archive: %s\n", strings.Join(failedKeys, ", "))
+		for _, k := range failedKeys {
+			fmt.Printf("  %s: %s\n", k, failed[k])
+		}
+	}
+	return nil
+}
@@ -3,27 +3,35 @@ package cmd
 import (
 	"fmt"
 
+	"github.com/novshi-tech/atl-cli/internal/jira"
 	"github.com/spf13/cobra"
 )
 
 var issueCreateCmd = &cobra.Command{
 	Use:   "create",
 	Short: "Create a new Jira issue",
-	RunE:  runIssueCreate,
+	Long: "Create a new Jira issue. --project, --summary and --type are required " +
+		"unless --editor is used, which opens $EDITOR on a template (pre-filled " +
+		"from any flags given) with the fields as YAML front matter followed by " +
+		"the Markdown description.",
+	RunE: runIssueCreate,
 }
 
 func init() {
-	issueCreateCmd.Flags().StringP("project", "p", "", "Project key (required)")
-	issueCreateCmd.MarkFlagRequired("project")
-	issueCreateCmd.Flags().StringP("summary", "s", "", "Issue summary (required)")
-	issueCreateCmd.MarkFlagRequired("summary")
-	issueCreateCmd.Flags().StringP("type", "t", "", "Issue type name (required; run 'atl jira issuetype list --project <key>' to discover)")
-	issueCreateCmd.MarkFlagRequired("type")
+	issueCreateCmd.Flags().StringP("project", "p", "", "Project key (required unless --editor)")
+	issueCreateCmd.Flags().StringP("summary", "s", "", "Issue summary (required unless --editor)")
+	issueCreateCmd.Flags().StringP("type", "t", "", "Issue type name (required unless --editor; run 'atl jira issuetype list --project <key>' to discover)")
 	issueCreateCmd.Flags().StringP("description", "d", "", "Issue description")
+	issueCreateCmd.Flags().String("body-file", "", "Read the description from a file ('-' for stdin)")
+	issueCreateCmd.MarkFlagsMutuallyExclusive("description", "body-file")
 	issueCreateCmd.Flags().String("due", "", "Due date (YYYY-MM-DD)")
 	issueCreateCmd.Flags().String("epic", "", "Epic key to link this issue to")
 	issueCreateCmd.Flags().String("parent", "", "Parent issue key (e.g. parent task for a sub-task)")
 	issueCreateCmd.MarkFlagsMutuallyExclusive("epic", "parent")
+	issueCreateCmd.Flags().StringSlice("label", nil, "Label(s) to add")
+	issueCreateCmd.Flags().String("assignee", "", "Assignee: \"me\", an email address, display name, or account ID")
+	issueCreateCmd.Flags().Bool("editor", false, "Compose the issue in $EDITOR from a template")
+	issueCreateCmd.MarkFlagsMutuallyExclusive("editor", "body-file")
 	issueCmd.AddCommand(issueCreateCmd)
 }
 
@@ -37,16 +45,72 @@ func runIssueCreate(cmd *cobra.Command, args []string) error {
 	summary, _ := cmd.Flags().GetString("summary")
 	issueType, _ := cmd.Flags().GetString("type")
 	description, _ := cmd.Flags().GetString("description")
+	bodyFile, _ := cmd.Flags().GetString("body-file")
 	due, _ := cmd.Flags().GetString("due")
 	epic, _ := cmd.Flags().GetString("epic")
 	parent, _ := cmd.Flags().GetString("parent")
+	labels, _ := cmd.Flags().GetStringSlice("label")
+	assignee, _ := cmd.Flags().GetString("assignee")
+	useEditor, _ := cmd.Flags().GetBool("editor")
 
 	parentKey := epic
 	if parent != "" {
 		parentKey = parent
 	}
 
-	resp, err := client.CreateIssue(project, issueType, summary, description, due, parentKey)
+	if bodyFile != "" {
+		description, err = readBodyFile(bodyFile)
+		if err != nil {
+			return err
+		}
+	}
+
+	var customFields map[string]any
+	if useEditor {
+		tmpl := issueTemplate{
+			Project:  project,
+			Type:     issueType,
+			Summary:  summary,
+			Labels:   labels,
+			Assignee: assignee,
+			Parent:   parentKey,
+			Due:      due,
+		}
+		edited, err := editInEditor(renderIssueTemplate(tmpl, description, true), "atl-issue-*.md")
+		if err != nil {
+			return err
+		}
+		tmpl, description, err = parseIssueTemplate(edited)
+		if err != nil {
+			return err
+		}
+		if tmpl.Summary == "" {
+			return fmt.Errorf("aborted: summary is empty")
+		}
+		project, issueType, summary = tmpl.Project, tmpl.Type, tmpl.Summary
+		labels, assignee, parentKey, due = tmpl.Labels, tmpl.Assignee, tmpl.Parent, tmpl.Due
+		customFields = tmpl.Fields
+	}
+
+	if project == "" || summary == "" || issueType == "" {
+		return fmt.Errorf("--project, --summary and --type are required (or use --editor)")
+	}
+
+	opts := jira.CreateIssueOptions{
+		Description:  description,
+		DueDate:      due,
+		ParentKey:    parentKey,
+		Labels:       labels,
+		CustomFields: customFields,
+	}
+	if assignee != "" {
+		opts.AssigneeID, err = client.ResolveAccountID(assignee)
+		if err != nil {
+			return err
+		}
+	}
+
+	resp, err := client.CreateIssue(project, issueType, summary, opts)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+var issueDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete a Jira issue",
+	Long: "Delete a Jira issue. A summary of what will be removed is shown and " +
+		"confirmation is required; pass --yes to skip the prompt (required when " +
+		"stdin is not a terminal).",
+	RunE: runIssueDelete,
+}
+
+func init() {
+	issueDeleteCmd.Flags().StringP("key", "k", "", "Issue key (required)")
+	issueDeleteCmd.MarkFlagRequired("key")
+	issueDeleteCmd.Flags().Bool("delete-subtasks", false, "Also delete the issue's sub-tasks (required if it has any)")
+	issueDeleteCmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+	issueCmd.AddCommand(issueDeleteCmd)
+}
+
+func runIssueDelete(cmd *cobra.Command, args []string) error {
+	client, err := newJiraClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	key, _ := cmd.Flags().GetString("key")
+	deleteSubtasks, _ := cmd.Flags().GetBool("delete-subtasks")
+
+	issue, err := client.GetIssue(key)
+	if err != nil {
+		return err
+	}
+
+	if len(issue.Fields.Subtasks) > 0 && !deleteSubtasks {
+		return fmt.Errorf("%s has %d sub-task(s); pass --delete-subtasks to delete them too", issue.Key, len(issue.Fields.Subtasks))
+	}
+
+	fmt.Fprintf(os.Stderr, "The following will be permanently deleted:\n\n")
+	printIssueSummaryLine(issue.Key, issue.Fields.IssueType.Name, issue.Fields.Status.Name, issue.Fields.Summary)
+	var subtaskKeys []string
+	for _, st := range issue.Fields.Subtasks {
+		printIssueSummaryLine("  "+st.Key, st.Fields.IssueType.Name, st.Fields.Status.Name, st.Fields.Summary)
+		subtaskKeys = append(subtaskKeys, st.Key)
+	}
+	fmt.Fprintln(os.Stderr)
+
+	if err := confirmDestructive(cmd, fmt.Sprintf("Delete %s?", issue.Key)); err != nil {
+		return err
+	}
+
+	if err := client.DeleteIssue(issue.Key, deleteSubtasks); err != nil {
+		return err
+	}
+
+	if jsonMode(cmd) {
+		return printJSON(JSONIssueDeleteResult{
+			Key:             issue.Key,
+			DeletedSubtasks: subtaskKeys,
+		})
+	}
+
+	fmt.Printf("Deleted issue: %s\n", issue.Key)
+	if len(subtaskKeys) > 0 {
+		fmt.Printf("Deleted %d sub-task(s)\n", len(subtaskKeys))
+	}
+	return nil
+}
+
+// printIssueSummaryLine writes a one-line issue summary to stderr, used to
+// show what a destructive command is about to touch before confirming.
+func printIssueSummaryLine(key, issueType, status, summary string) {
+	fmt.Fprintf(os.Stderr, "%-14s  %-12s  %-15s  %s\n", key, issueType, status, summary)
+}
@@ -3,18 +3,34 @@ package cmd
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
+	"github.com/novshi-tech/atl-cli/internal/jira"
 	"github.com/spf13/cobra"
 )
 
 var issueListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "Search for issues using JQL",
-	RunE:  runIssueList,
+	Long: "Search for issues using JQL, a saved filter, and/or shortcut flags. " +
+		"Shortcut flags (--project, --assignee, --status, ...) are turned into " +
+		"JQL clauses and ANDed with --jql or the filter's query, if given.",
+	RunE: runIssueList,
 }
 
 func init() {
-	issueListCmd.Flags().String("jql", "", "JQL query string (required)")
+	issueListCmd.Flags().String("jql", "", "JQL query string")
+	issueListCmd.Flags().String("filter", "", "Saved filter ID or exact name whose JQL to run")
+	issueListCmd.MarkFlagsMutuallyExclusive("jql", "filter")
+	issueListCmd.Flags().StringSliceP("project", "p", nil, "Project key(s)")
+	issueListCmd.Flags().String("assignee", "", "Assignee: \"me\", \"none\", an email address, display name, or account ID")
+	issueListCmd.Flags().StringSlice("status", nil, "Status name(s)")
+	issueListCmd.Flags().StringSliceP("type", "t", nil, "Issue type name(s)")
+	issueListCmd.Flags().StringSlice("label", nil, "Label(s)")
+	issueListCmd.Flags().String("sprint", "", "Sprint: active, future, closed, a sprint ID, or a sprint name")
+	issueListCmd.Flags().String("updated-since", "", "Only issues updated within this duration (e.g. 7d, 2w, 4h) or since a date (YYYY-MM-DD)")
+	issueListCmd.Flags().String("text", "", "Full-text search across summary, description and comments")
+	issueListCmd.Flags().String("order-by", "", "ORDER BY clause, e.g. \"updated DESC\" (overrides any in --jql/--filter)")
 	issueListCmd.Flags().Int("max", 50, "Maximum number of results")
 	issueCmd.AddCommand(issueListCmd)
 }
@@ -26,10 +42,45 @@ func runIssueList(cmd *cobra.Command, args []string) error {
 	}
 
 	jql, _ := cmd.Flags().GetString("jql")
+	filter, _ := cmd.Flags().GetString("filter")
 	max, _ := cmd.Flags().GetInt("max")
 
+	if filter != "" {
+		f, err := client.ResolveFilter(filter)
+		if err != nil {
+			return err
+		}
+		jql = f.JQL
+	}
+
+	criteria := jira.SearchCriteria{}
+	criteria.Projects, _ = cmd.Flags().GetStringSlice("project")
+	criteria.Assignee, _ = cmd.Flags().GetString("assignee")
+	criteria.Statuses, _ = cmd.Flags().GetStringSlice("status")
+	criteria.Types, _ = cmd.Flags().GetStringSlice("type")
+	criteria.Labels, _ = cmd.Flags().GetStringSlice("label")
+	criteria.Sprint, _ = cmd.Flags().GetString("sprint")
+	criteria.UpdatedSince, _ = cmd.Flags().GetString("updated-since")
+	criteria.Text, _ = cmd.Flags().GetString("text")
+	criteria.OrderBy, _ = cmd.Flags().GetString("order-by")
+
+	// "me" and "none" map to JQL functions; anything else must become an
+	// account ID since JQL on Jira Cloud no longer accepts emails or names.
+	if a := strings.ToLower(criteria.Assignee); a != "" && a != "me" && a != "none" {
+		accountID, err := client.ResolveAccountID(criteria.Assignee)
+		if err != nil {
+			return err
+		}
+		criteria.Assignee = accountID
+	}
+
+	jql, err = jira.BuildJQL(jql, criteria)
+	if err != nil {
+		return err
+	}
+
 	if jql == "" {
-		return fmt.Errorf("--jql is required")
+		return fmt.Errorf("specify --jql, --filter, or at least one search flag (e.g. --project, --assignee)")
 	}
 
 	resp, err := client.SearchIssues(jql, max)
@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// issueTemplate is the YAML front matter of the template that
+// `issue create --editor` and `issue update --editor` open in $EDITOR. The
+// Markdown body after the front matter becomes the description.
+type issueTemplate struct {
+	Project  string         `yaml:"project"`
+	Type     string         `yaml:"type"`
+	Summary  string         `yaml:"summary"`
+	Labels   []string       `yaml:"labels"`
+	Assignee string         `yaml:"assignee"`
+	Parent   string         `yaml:"parent"`
+	Due      string         `yaml:"due"`
+	Fields   map[string]any `yaml:"fields"`
+}
+
+// renderIssueTemplate renders t and body as an editable document. project
+// and type are only included for creation, since update can't change them.
+func renderIssueTemplate(t issueTemplate, body string, forCreate bool) string {
+	var b strings.Builder
+	b.WriteString("---\n")
+	b.WriteString("# Edit the fields below and write the description (Markdown) after the\n")
+	b.WriteString("# closing '---'. Save and close the editor to submit")
+	if forCreate {
+		b.WriteString("; leave summary\n# empty to abort.\n")
+	} else {
+		b.WriteString(". Only changed\n# fields are sent.\n")
+	}
+	if forCreate {
+		fmt.Fprintf(&b, "project: %s\n", yamlScalar(t.Project))
+		fmt.Fprintf(&b, "type: %s\n", yamlScalar(t.Type))
+	}
+	fmt.Fprintf(&b, "summary: %s\n", yamlScalar(t.Summary))
+	quoted := make([]string, len(t.Labels))
+	for i, l := range t.Labels {
+		quoted[i] = yamlScalar(l)
+	}
+	fmt.Fprintf(&b, "labels: [%s]\n", strings.Join(quoted, ", "))
+	b.WriteString("# assignee: me, an email address, display name, or account ID\n")
+	fmt.Fprintf(&b, "assignee: %s\n", yamlScalar(t.Assignee))
+	b.WriteString("# parent: epic key, or the parent task key for a sub-task\n")
+	fmt.Fprintf(&b, "parent: %s\n", yamlScalar(t.Parent))
+	b.WriteString("# due: YYYY-MM-DD\n")
+	fmt.Fprintf(&b, "due: %s\n", yamlScalar(t.Due))
+	b.WriteString("# Additional fields, keyed by field ID or name, for example:\n")
+	b.WriteString("# fields:\n#   customfield_10016: 3\n#   Team: {value: Platform}\n")
+	if len(t.Fields) == 0 {
+		b.WriteString("fields: {}\n")
+	} else {
+		data, _ := yaml.Marshal(t.Fields)
+		b.WriteString("fields:\n")
+		for _, line := range strings.Split(strings.TrimRight(string(data), "\n"), "\n") {
+			b.WriteString("  " + line + "\n")
+		}
+	}
+	b.WriteString("---\n")
+	b.WriteString(body)
+	if body != "" && !strings.HasSuffix(body, "\n") {
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// parseIssueTemplate splits an edited template into its front matter and
+// Markdown body.
+func parseIssueTemplate(content string) (issueTemplate, string, error) {
+	var t issueTemplate
+	content = strings.TrimPrefix(content, "\ufeff")
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+	lines := strings.Split(content, "\n")
+
+	start := 0
+	for start < len(lines) && strings.TrimSpace(lines[start]) == "" {
+		start++
+	}
+	if start >= len(lines) || strings.TrimSpace(lines[start]) != "---" {
+		return t, "", fmt.Errorf("template must start with a '---' front matter block")
+	}
+	end := -1
+	for i := start + 1; i < len(lines); i++ {
+		if strings.TrimSpace(lines[i]) == "---" {
+			end = i
+			break
+		}
+	}
+	if end < 0 {
+		return t, "", fmt.Errorf("front matter is missing its closing '---'")
+	}
+
+	front := strings.Join(lines[start+1:end], "\n")
+	if err := yaml.Unmarshal([]byte(front), &t); err != nil {
+		return t, "", fmt.Errorf("parsing front matter: %w", err)
+	}
+	body := strings.TrimSpace(strings.Join(lines[end+1:], "\n"))
+	return t, body, nil
+}
+
+// yamlScalar renders s as a single-line YAML scalar, quoting it when needed.
+func yamlScalar(s string) string {
+	data, err := yaml.Marshal(s)
+	if err != nil {
+		return fmt.Sprintf("%q", s)
+	}
+	return strings.TrimRight(string(data), "\n")
+}
@@ -2,14 +2,21 @@ package cmd
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 
+	"github.com/novshi-tech/atl-cli/internal/adf"
+	"github.com/novshi-tech/atl-cli/internal/jira"
 	"github.com/spf13/cobra"
 )
 
 var issueUpdateCmd = &cobra.Command{
 	Use:   "update",
 	Short: "Update an existing Jira issue",
-	RunE:  runIssueUpdate,
+	Long: "Update an existing Jira issue. With --editor, $EDITOR is opened on a " +
+		"template pre-filled with the issue's current summary, labels, assignee, " +
+		"parent, due date and description; only the fields you change are sent.",
+	RunE: runIssueUpdate,
 }
 
 func init() {
@@ -17,13 +24,22 @@ func init() {
 	issueUpdateCmd.MarkFlagRequired("key")
 	issueUpdateCmd.Flags().StringP("summary", "s", "", "New summary")
 	issueUpdateCmd.Flags().StringP("description", "d", "", "New description")
+	issueUpdateCmd.Flags().String("body-file", "", "Read the new description from a file ('-' for stdin)")
+	issueUpdateCmd.MarkFlagsMutuallyExclusive("description", "body-file")
+	issueUpdateCmd.Flags().Bool("editor", false, "Edit the issue's fields and description in $EDITOR")
 	issueUpdateCmd.Flags().String("status", "", "Transition to this status")
-	issueUpdateCmd.Flags().String("assignee", "", "Assignee account ID (use \"none\" to unassign)")
+	issueUpdateCmd.Flags().String("assignee", "", "Assignee: \"me\", an email address, display name, or account ID (use \"none\" to unassign)")
+	issueUpdateCmd.Flags().String("reporter", "", "Reporter: \"me\", an email address, display name, or account ID")
+	issueUpdateCmd.Flags().StringSlice("add-watcher", nil, "Add watcher(s): \"me\", email, display name, or account ID")
+	issueUpdateCmd.Flags().StringSlice("remove-watcher", nil, "Remove watcher(s): \"me\", email, display name, or account ID")
 	issueUpdateCmd.Flags().String("due", "", "Due date (YYYY-MM-DD)")
 	issueUpdateCmd.Flags().String("epic", "", "Epic key to link this issue to")
 	issueUpdateCmd.Flags().String("parent", "", "Parent issue key (e.g. parent task for a sub-task)")
 	issueUpdateCmd.MarkFlagsMutuallyExclusive("epic", "parent")
 	issueUpdateCmd.Flags().Float64("story-points", 0, "Story points estimate")
+	for _, f := range []string{"summary", "description", "body-file", "assignee", "due", "epic", "parent"} {
+		issueUpdateCmd.MarkFlagsMutuallyExclusive("editor", f)
+	}
 	issueCmd.AddCommand(issueUpdateCmd)
 }
 
@@ -36,9 +52,14 @@ func runIssueUpdate(cmd *cobra.Command, args []string) error {
 	key, _ := cmd.Flags().GetString("key")
 	summary, _ := cmd.Flags().GetString("summary")
 	description, _ := cmd.Flags().GetString("description")
+	bodyFile, _ := cmd.Flags().GetString("body-file")
+	useEditor, _ := cmd.Flags().GetBool("editor")
 	status, _ := cmd.Flags().GetString("status")
 	assignee, _ := cmd.Flags().GetString("assignee")
 	assigneeChanged := cmd.Flags().Changed("assignee")
+	reporter, _ := cmd.Flags().GetString("reporter")
+	addWatchers, _ := cmd.Flags().GetStringSlice("add-watcher")
+	removeWatchers, _ := cmd.Flags().GetStringSlice("remove-watcher")
 	due, _ := cmd.Flags().GetString("due")
 	epic, _ := cmd.Flags().GetString("epic")
 	parent, _ := cmd.Flags().GetString("parent")
@@ -50,11 +71,19 @@ func runIssueUpdate(cmd *cobra.Command, args []string) error {
 	storyPoints, _ := cmd.Flags().GetFloat64("story-points")
 	storyPointsChanged := cmd.Flags().Changed("story-points")
 
-	if summary == "" && description == "" && status == "" && !assigneeChanged && due == "" && !parentChanged && !storyPointsChanged {
+	if bodyFile != "" {
+		description, err = readBodyFile(bodyFile)
+		if err != nil {
+			return err
+		}
+	}
+
+	if summary == "" && description == "" && !useEditor && status == "" && !assigneeChanged && reporter == "" &&
+		len(addWatchers) == 0 && len(removeWatchers) == 0 && due == "" && !parentChanged && !storyPointsChanged {
 		if jsonMode(cmd) {
 			return printJSON(JSONMutationResult{Key: key, URL: fmt.Sprintf("%s/browse/%s", client.BaseURL(), key)})
 		}
-		fmt.Println("Nothing to update. Specify --summary, --description, --status, --assignee, --due, --epic, --parent, or --story-points.")
+		fmt.Println("Nothing to update. Specify --editor, --summary, --description, --status, --assignee, --reporter, --add-watcher, --remove-watcher, --due, --epic, --parent, or --story-points.")
 		fmt.Printf("URL: %s/browse/%s\n", client.BaseURL(), key)
 		return nil
 	}
@@ -68,6 +97,23 @@ func runIssueUpdate(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	if useEditor {
+		edit, changed, err := editIssueInEditor(client, key)
+		if err != nil {
+			return err
+		}
+		if changed {
+			if err := client.EditIssue(key, edit); err != nil {
+				return err
+			}
+			if !jsonMode(cmd) {
+				fmt.Printf("Updated issue: %s\n", key)
+			}
+		} else if !jsonMode(cmd) {
+			fmt.Println("No changes made in the editor.")
+		}
+	}
+
 	if assigneeChanged {
 		if assignee == "none" || assignee == "" {
 			if err := client.AssignIssue(key, nil); err != nil {
@@ -77,15 +123,58 @@ func runIssueUpdate(cmd *cobra.Command, args []string) error {
 				fmt.Printf("Unassigned %s\n", key)
 			}
 		} else {
-			if err := client.AssignIssue(key, &assignee); err != nil {
+			user, err := client.ResolveUser(assignee)
+			if err != nil {
+				return err
+			}
+			if err := client.AssignIssue(key, &user.AccountID); err != nil {
 				return err
 			}
 			if !jsonMode(cmd) {
-				fmt.Printf("Assigned %s to %s\n", key, assignee)
+				fmt.Printf("Assigned %s to %s\n", key, user.DisplayName)
 			}
 		}
 	}
 
+	if reporter != "" {
+		user, err := client.ResolveUser(reporter)
+		if err != nil {
+			return err
+		}
+		if err := client.SetReporter(key, user.AccountID); err != nil {
+			return err
+		}
+		if !jsonMode(cmd) {
+			fmt.Printf("Set reporter of %s to %s\n", key, user.DisplayName)
+		}
+	}
+
+	for _, w := range addWatchers {
+		user, err := client.ResolveUser(w)
+		if err != nil {
+			return err
+		}
+		if err := client.AddWatcher(key, user.AccountID); err != nil {
+			return err
+		}
+		if !jsonMode(cmd) {
+			fmt.Printf("Added %s as a watcher of %s\n", user.DisplayName, key)
+		}
+	}
+
+	for _, w := range removeWatchers {
+		user, err := client.ResolveUser(w)
+		if err != nil {
+			return err
+		}
+		if err := client.RemoveWatcher(key, user.AccountID); err != nil {
+			return err
+		}
+		if !jsonMode(cmd) {
+			fmt.Printf("Removed %s from the watchers of %s\n", user.DisplayName, key)
+		}
+	}
+
 	if status != "" {
 		if err := client.TransitionIssue(key, status); err != nil {
 			return err
@@ -114,3 +203,88 @@ func runIssueUpdate(cmd *cobra.Command, args []string) error {
 	fmt.Printf("URL: %s/browse/%s\n", client.BaseURL(), key)
 	return nil
 }
+
+// editIssueInEditor opens the issue's current values in $EDITOR and returns
+// an IssueEdit holding only the fields the user changed.
+func editIssueInEditor(client *jira.Client, key string) (jira.IssueEdit, bool, error) {
+	var edit jira.IssueEdit
+
+	issue, err := client.GetIssue(key)
+	if err != nil {
+		return edit, false, err
+	}
+
+	orig := issueTemplate{
+		Summary: issue.Fields.Summary,
+		Labels:  issue.Fields.Labels,
+		Due:     issue.Fields.DueDate,
+	}
+	if a := issue.Fields.Assignee; a != nil {
+		orig.Assignee = a.DisplayName
+		if a.EmailAddress != "" {
+			orig.Assignee = a.EmailAddress
+		}
+	}
+	if issue.Fields.Parent != nil {
+		orig.Parent = issue.Fields.Parent.Key
+	}
+	origBody := strings.TrimSpace(adfToText(issue.Fields.Description))
+
+	edited, err := editInEditor(renderIssueTemplate(orig, origBody, false), "atl-"+key+"-*.md")
+	if err != nil {
+		return edit, false, err
+	}
+	t, body, err := parseIssueTemplate(edited)
+	if err != nil {
+		return edit, false, err
+	}
+
+	changed := false
+	if t.Summary != orig.Summary {
+		if t.Summary == "" {
+			return edit, false, fmt.Errorf("summary cannot be empty")
+		}
+		edit.Summary = &t.Summary
+		changed = true
+	}
+	if body != origBody {
+		// The template holds the description as plain text, so replacing
+		// a description with tables, mentions, formatting and the like
+		// would silently drop them.
+		if rich := adf.RichContent(issue.Fields.Description); len(rich) > 0 {
+			return edit, false, fmt.Errorf("the description of %s contains content that can't be edited as text (%s); "+
+				"nothing was updated. Edit it in Jira, or replace it with --description or --body-file",
+				key, strings.Join(rich, ", "))
+		}
+		edit.Description = &body
+		changed = true
+	}
+	if !slices.Equal(t.Labels, orig.Labels) {
+		edit.Labels = &t.Labels
+		changed = true
+	}
+	if t.Assignee != orig.Assignee {
+		accountID := ""
+		if t.Assignee != "" && t.Assignee != "none" {
+			accountID, err = client.ResolveAccountID(t.Assignee)
+			if err != nil {
+				return edit, false, err
+			}
+		}
+		edit.AssigneeID = &accountID
+		changed = true
+	}
+	if t.Parent != orig.Parent {
+		edit.ParentKey = &t.Parent
+		changed = true
+	}
+	if t.Due != orig.Due {
+		edit.DueDate = &t.Due
+		changed = true
+	}
+	if len(t.Fields) > 0 {
+		edit.CustomFields = t.Fields
+		changed = true
+	}
+	return edit, changed, nil
+}
@@ -0,0 +1,191 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/novshi-tech/atl-cli/internal/jira"
+	"github.com/spf13/cobra"
+)
+
+var jqlCmd = &cobra.Command{
+	Use:   "jql",
+	Short: "Validate JQL and look up JQL fields and values",
+}
+
+var jqlValidateCmd = &cobra.Command{
+	Use:   "validate <query>",
+	Short: "Validate a JQL query against this site",
+	Long: "Validate a JQL query using Jira's parser with strict validation, which " +
+		"also checks that fields, values and functions exist. Errors are shown " +
+		"with their position in the query. Exits non-zero if the query is invalid.",
+	Args: cobra.ExactArgs(1),
+	RunE: runJQLValidate,
+}
+
+var jqlAutocompleteCmd = &cobra.Command{
+	Use:   "autocomplete",
+	Short: "List JQL fields, functions, or value suggestions for a field",
+	RunE:  runJQLAutocomplete,
+}
+
+func init() {
+	jqlAutocompleteCmd.Flags().String("field", "", "List value suggestions for this field (e.g. status, project)")
+	jqlAutocompleteCmd.Flags().String("value", "", "Only suggest values starting with this prefix (used with --field)")
+	jqlAutocompleteCmd.Flags().Bool("functions", false, "List JQL functions instead of fields")
+	jqlAutocompleteCmd.MarkFlagsMutuallyExclusive("field", "functions")
+
+	jqlCmd.AddCommand(jqlValidateCmd)
+	jqlCmd.AddCommand(jqlAutocompleteCmd)
+	jiraCmd.AddCommand(jqlCmd)
+}
+
+func runJQLValidate(cmd *cobra.Command, args []string) error {
+	client, err := newJiraClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	query := args[0]
+
+	parsed, err := client.ParseJQL(query)
+	if err != nil {
+		return err
+	}
+
+	if jsonMode(cmd) {
+		result := JSONJQLValidation{
+			Query:    query,
+			Valid:    len(parsed.Errors) == 0,
+			Warnings: parsed.Warnings,
+		}
+		for _, e := range parsed.Errors {
+			item := JSONJQLError{Message: e}
+			if line, char, ok := jira.JQLErrorPosition(e); ok {
+				item.Line = line
+				item.Character = char
+			}
+			result.Errors = append(result.Errors, item)
+		}
+		if err := printJSON(result); err != nil {
+			return err
+		}
+	} else if len(parsed.Errors) == 0 {
+		fmt.Println("JQL is valid.")
+		for _, w := range parsed.Warnings {
+			fmt.Printf("Warning: %s\n", w)
+		}
+	} else {
+		fmt.Printf("JQL is invalid (%d error(s)):\n", len(parsed.Errors))
+		lines := strings.Split(query, "\n")
+		for _, e := range parsed.Errors {
+			fmt.Println()
+			if line, char, ok := jira.JQLErrorPosition(e); ok && line >= 1 && line <= len(lines) {
+				fmt.Printf("  %s\n", lines[line-1])
+				fmt.Printf("  %s^\n", strings.Repeat(" ", max(char-1, 0)))
+			}
+			fmt.Printf("  %s\n", e)
+		}
+	}
+
+	if len(parsed.Errors) > 0 {
+		// The errors were already reported above; don't follow them with usage.
+		cmd.SilenceUsage = true
+		return fmt.Errorf("invalid JQL")
+	}
+	return nil
+}
+
+func runJQLAutocomplete(cmd *cobra.Command, args []string) error {
+	client, err := newJiraClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	field, _ := cmd.Flags().GetString("field")
+	value, _ := cmd.Flags().GetString("value")
+	functions, _ := cmd.Flags().GetBool("functions")
+
+	if field != "" {
+		suggestions, err := client.GetJQLSuggestions(field, value)
+		if err != nil {
+			return err
+		}
+		if jsonMode(cmd) {
+			items := make([]JSONJQLSuggestion, len(suggestions))
+			for i, s := range suggestions {
+				items[i] = JSONJQLSuggestion{Value: s.Value, DisplayName: s.DisplayName}
+			}
+			return printJSON(items)
+		}
+		if len(suggestions) == 0 {
+			fmt.Println("No suggestions found.")
+			return nil
+		}
+		for _, s := range suggestions {
+			fmt.Printf("%-30s  %s\n", s.Value, stripHTMLTags(s.DisplayName))
+		}
+		return nil
+	}
+
+	data, err := client.GetJQLAutocompleteData()
+	if err != nil {
+		return err
+	}
+
+	if functions {
+		if jsonMode(cmd) {
+			items := make([]JSONJQLFunction, len(data.VisibleFunctionNames))
+			for i, f := range data.VisibleFunctionNames {
+				items[i] = JSONJQLFunction{
+					Value:       f.Value,
+					DisplayName: f.DisplayName,
+					IsList:      f.IsList == "true",
+					Types:       f.Types,
+				}
+			}
+			return printJSON(items)
+		}
+		for _, f := range data.VisibleFunctionNames {
+			fmt.Printf("%-40s  %s\n", f.Value, f.DisplayName)
+		}
+		return nil
+	}
+
+	if jsonMode(cmd) {
+		items := make([]JSONJQLField, len(data.VisibleFieldNames))
+		for i, f := range data.VisibleFieldNames {
+			items[i] = JSONJQLField{
+				Value:       f.Value,
+				DisplayName: f.DisplayName,
+				Orderable:   f.Orderable == "true",
+				Searchable:  f.Searchable == "true",
+				Operators:   f.Operators,
+				Types:       f.Types,
+			}
+		}
+		return printJSON(items)
+	}
+	for _, f := range data.VisibleFieldNames {
+		fmt.Printf("%-30s  %-30s  %s\n", f.Value, f.DisplayName, strings.Join(f.Operators, " "))
+	}
+	return nil
+}
+
+// stripHTMLTags removes the <b>…</b> highlighting Jira adds to the
+// matched prefix in suggestion display names.
+func stripHTMLTags(s string) string {
+	var b strings.Builder
+	inTag := false
+	for _, r := range s {
+		switch {
+		case r == '<':
+			inTag = true
+		case r == '>':
+			inTag = false
+		case !inTag:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
@@ -77,6 +77,65 @@ type JSONMutationResult struct {
 	URL string `json:"url"`
 }
 
+type JSONIssueDeleteResult struct {
+	Key             string   `json:"key"`
+	DeletedSubtasks []string `json:"deletedSubtasks,omitempty"`
+}
+
+type JSONIssueArchiveResult struct {
+	Archived int                       `json:"archived"`
+	Failed   []JSONIssueArchiveFailure `json:"failed,omitempty"`
+}
+
+type JSONIssueArchiveFailure struct {
+	Key   string `json:"key"`
+	Error string `json:"error"`
+}
+
+type JSONFilterItem struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	JQL         string `json:"jql"`
+	Description string `json:"description,omitempty"`
+	Owner       string `json:"owner,omitempty"`
+	Favourite   bool   `json:"favourite"`
+	URL         string `json:"url,omitempty"`
+}
+
+type JSONJQLValidation struct {
+	Query    string         `json:"query"`
+	Valid    bool           `json:"valid"`
+	Errors   []JSONJQLError `json:"errors,omitempty"`
+	Warnings []string       `json:"warnings,omitempty"`
+}
+
+type JSONJQLError struct {
+	Message   string `json:"message"`
+	Line      int    `json:"line,omitempty"`
+	Character int    `json:"character,omitempty"`
+}
+
+type JSONJQLField struct {
+	Value       string   `json:"value"`
+	DisplayName string   `json:"displayName"`
+	Orderable   bool     `json:"orderable"`
+	Searchable  bool     `json:"searchable"`
+	Operators   []string `json:"operators,omitempty"`
+	Types       []string `json:"types,omitempty"`
+}
+
+type JSONJQLFunction struct {
+	Value       string   `json:"value"`
+	DisplayName string   `json:"displayName"`
+	IsList      bool     `json:"isList"`
+	Types       []string `json:"types,omitempty"`
+}
+
+type JSONJQLSuggestion struct {
+	Value       string `json:"value"`
+	DisplayName string `json:"displayName"`
+}
+
 type JSONUserItem struct {
 	AccountID    string `json:"accountId"`
 	DisplayName  string `json:"displayName"`
@@ -97,6 +156,7 @@ type JSONRepoItem struct {
 	Name      string `json:"name"`
 	Language  string `json:"language"`
 	IsPrivate bool   `json:"is_private"`
+	Project   string `json:"project,omitempty"`
 }
 
 type JSONProjectItem struct {
@@ -114,12 +174,14 @@ type JSONIssueTypeItem struct {
 }
 
 type JSONPRItem struct {
+	Repo   string `json:"repo,omitempty"`
 	ID     int    `json:"id"`
 	Title  string `json:"title"`
 	State  string `json:"state"`
 	Author string `json:"author"`
 	Source string `json:"source"`
 	Dest   string `json:"destination"`
+	URL    string `json:"url,omitempty"`
 }
 
 type JSONRepoDetail struct {
@@ -131,4 +193,288 @@ type JSONRepoDetail struct {
 	IsPrivate   bool   `json:"is_private"`
 	MainBranch  string `json:"mainbranch"`
 	UpdatedOn   string `json:"updated_on"`
+	Project     string `json:"project,omitempty"`
+	HasIssues   bool   `json:"has_issues"`
+	ForkOf      string `json:"fork_of,omitempty"`
+}
+
+type JSONPRDetail struct {
+	ID                int                 `json:"id"`
+	Title             string              `json:"title"`
+	State             string              `json:"state"`
+	Draft             bool                `json:"draft"`
+	Author            string              `json:"author"`
+	Source            string              `json:"source"`
+	Dest              string              `json:"destination"`
+	SourceCommit      string              `json:"source_commit,omitempty"`
+	MergeCommit       string              `json:"merge_commit,omitempty"`
+	CloseSourceBranch bool                `json:"close_source_branch"`
+	Description       string              `json:"description"`
+	CreatedOn         string              `json:"created_on"`
+	UpdatedOn         string              `json:"updated_on"`
+	CommentCount      int                 `json:"comment_count"`
+	OpenTaskCount     int                 `json:"open_task_count"`
+	Reviewers         []JSONPRParticipant `json:"reviewers"`
+	Participants      []JSONPRParticipant `json:"participants"`
+	Statuses          []JSONCommitStatus  `json:"statuses"`
+	Mergeable         bool                `json:"mergeable"`
+	MergeBlockers     []string            `json:"merge_blockers,omitempty"`
+	URL               string              `json:"url"`
+}
+
+type JSONPRParticipant struct {
+	DisplayName string `json:"display_name"`
+	AccountID   string `json:"account_id,omitempty"`
+	Role        string `json:"role"`
+	Approved    bool   `json:"approved"`
+	State       string `json:"state,omitempty"`
+}
+
+type JSONCommitStatus struct {
+	Key         string `json:"key"`
+	Name        string `json:"name"`
+	State       string `json:"state"`
+	URL         string `json:"url"`
+	Description string `json:"description,omitempty"`
+	UpdatedOn   string `json:"updated_on"`
+}
+
+type JSONDiffStatItem struct {
+	Path         string `json:"path"`
+	OldPath      string `json:"old_path,omitempty"`
+	Status       string `json:"status"`
+	LinesAdded   int    `json:"lines_added"`
+	LinesRemoved int    `json:"lines_removed"`
+}
+
+type JSONFileDiff struct {
+	Path    string `json:"path"`
+	OldPath string `json:"old_path,omitempty"`
+	Diff    string `json:"diff"`
+}
+
+type JSONPRTaskItem struct {
+	ID         int    `json:"id"`
+	State      string `json:"state"`
+	Body       string `json:"body"`
+	Creator    string `json:"creator"`
+	Created    string `json:"created"`
+	CommentID  int    `json:"comment_id,omitempty"`
+	ResolvedBy string `json:"resolved_by,omitempty"`
+}
+
+type JSONCommentThread struct {
+	ID         int                 `json:"id"`
+	Author     string              `json:"author"`
+	Created    string              `json:"created"`
+	Path       string              `json:"path,omitempty"`
+	From       *int                `json:"from,omitempty"`
+	To         *int                `json:"to,omitempty"`
+	Body       string              `json:"body"`
+	Resolved   bool                `json:"resolved,omitempty"`
+	ResolvedBy string              `json:"resolved_by,omitempty"`
+	Replies    []JSONCommentThread `json:"replies,omitempty"`
+}
+
+type JSONPRActivityEvent struct {
+	Type      string `json:"type"`
+	Date      string `json:"date"`
+	User      string `json:"user"`
+	CommentID int    `json:"comment_id,omitempty"`
+	ParentID  int    `json:"parent_id,omitempty"`
+	Path      string `json:"path,omitempty"`
+	Line      *int   `json:"line,omitempty"`
+	Body      string `json:"body,omitempty"`
+	State     string `json:"state,omitempty"`
+	Commit    string `json:"commit,omitempty"`
+}
+
+type JSONPipelineItem struct {
+	BuildNumber     int    `json:"build_number"`
+	UUID            string `json:"uuid"`
+	State           string `json:"state"`
+	Status          string `json:"status"`
+	RefType         string `json:"ref_type,omitempty"`
+	RefName         string `json:"ref_name,omitempty"`
+	Commit          string `json:"commit,omitempty"`
+	Pipeline        string `json:"pipeline,omitempty"`
+	Trigger         string `json:"trigger"`
+	Creator         string `json:"creator,omitempty"`
+	CreatedOn       string `json:"created_on"`
+	CompletedOn     string `json:"completed_on,omitempty"`
+	DurationSeconds int    `json:"duration_seconds"`
+	URL             string `json:"url"`
+}
+
+type JSONPipelineDetail struct {
+	JSONPipelineItem
+	Steps []JSONPipelineStep `json:"steps"`
+}
+
+type JSONPipelineStep struct {
+	UUID            string `json:"uuid"`
+	Name            string `json:"name"`
+	State           string `json:"state"`
+	Status          string `json:"status"`
+	StartedOn       string `json:"started_on,omitempty"`
+	CompletedOn     string `json:"completed_on,omitempty"`
+	DurationSeconds int    `json:"duration_seconds"`
+}
+
+type JSONPipelineVariable struct {
+	UUID    string `json:"uuid"`
+	Key     string `json:"key"`
+	Value   string `json:"value,omitempty"`
+	Secured bool   `json:"secured"`
+}
+
+type JSONPipelineSchedule struct {
+	UUID        string `json:"uuid"`
+	Enabled     bool   `json:"enabled"`
+	CronPattern string `json:"cron_pattern"`
+	Branch      string `json:"branch"`
+	Pipeline    string `json:"pipeline,omitempty"`
+	CreatedOn   string `json:"created_on"`
+}
+
+type JSONPipelineCache struct {
+	UUID          string `json:"uuid"`
+	Name          string `json:"name"`
+	Path          string `json:"path"`
+	FileSizeBytes int64  `json:"file_size_bytes"`
+	CreatedOn     string `json:"created_on"`
+}
+
+type JSONRef struct {
+	Name    string `json:"name"`
+	Commit  string `json:"commit"`
+	Date    string `json:"date"`
+	Author  string `json:"author"`
+	Message string `json:"message"`
+	URL     string `json:"url"`
+}
+
+type JSONPrunedBranch struct {
+	Name        string `json:"name"`
+	Commit      string `json:"commit"`
+	Date        string `json:"date"`
+	PullRequest int    `json:"pull_request"`
+	Deleted     bool   `json:"deleted"`
+	Error       string `json:"error,omitempty"`
+}
+
+type JSONCommitItem struct {
+	Hash    string `json:"hash"`
+	Author  string `json:"author"`
+	Date    string `json:"date"`
+	Message string `json:"message"`
+	URL     string `json:"url"`
+}
+
+type JSONCommitDetail struct {
+	Hash    string             `json:"hash"`
+	Author  string             `json:"author"`
+	Date    string             `json:"date"`
+	Message string             `json:"message"`
+	Parents []string           `json:"parents"`
+	Files   []JSONDiffStatItem `json:"files"`
+	URL     string             `json:"url"`
+}
+
+type JSONSrcEntry struct {
+	Path   string `json:"path"`
+	Type   string `json:"type"`
+	Size   int64  `json:"size,omitempty"`
+	Commit string `json:"commit,omitempty"`
+}
+
+type JSONFileRevision struct {
+	Commit  string `json:"commit"`
+	Path    string `json:"path"`
+	Author  string `json:"author"`
+	Date    string `json:"date"`
+	Message string `json:"message"`
+	URL     string `json:"url"`
+}
+
+type JSONBranchRestriction struct {
+	ID         int      `json:"id"`
+	Kind       string   `json:"kind"`
+	Pattern    string   `json:"pattern,omitempty"`
+	BranchType string   `json:"branch_type,omitempty"`
+	Value      *int     `json:"value,omitempty"`
+	Users      []string `json:"users"`
+	Groups     []string `json:"groups"`
+}
+
+type JSONRestrictionChange struct {
+	Repo    string `json:"repo"`
+	Action  string `json:"action"`
+	ID      int    `json:"id,omitempty"`
+	Kind    string `json:"kind"`
+	Match   string `json:"match"`
+	Applied bool   `json:"applied"`
+}
+
+type JSONDefaultReviewer struct {
+	DisplayName string `json:"display_name"`
+	Nickname    string `json:"nickname"`
+	UUID        string `json:"uuid"`
+}
+
+type JSONRepoPermission struct {
+	Repo       string `json:"repo,omitempty"`
+	Type       string `json:"type"`
+	Name       string `json:"name"`
+	ID         string `json:"id"`
+	Permission string `json:"permission"`
+}
+
+type JSONWorkspace struct {
+	Slug          string `json:"slug"`
+	Name          string `json:"name"`
+	UUID          string `json:"uuid"`
+	Administrator bool   `json:"administrator"`
+}
+
+type JSONWorkspaceDetail struct {
+	Slug      string `json:"slug"`
+	Name      string `json:"name"`
+	UUID      string `json:"uuid"`
+	IsPrivate bool   `json:"is_private"`
+	CreatedOn string `json:"created_on"`
+	URL       string `json:"url"`
+}
+
+type JSONWorkspaceMember struct {
+	DisplayName string `json:"display_name"`
+	Nickname    string `json:"nickname"`
+	AccountID   string `json:"account_id"`
+	UUID        string `json:"uuid"`
+}
+
+type JSONBBProject struct {
+	Key         string `json:"key"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	IsPrivate   bool   `json:"is_private"`
+	UpdatedOn   string `json:"updated_on"`
+	URL         string `json:"url"`
+}
+
+type JSONWebhook struct {
+	UUID        string   `json:"uuid"`
+	URL         string   `json:"url"`
+	Description string   `json:"description"`
+	SubjectType string   `json:"subject_type"`
+	Active      bool     `json:"active"`
+	Events      []string `json:"events"`
+	SecretSet   bool     `json:"secret_set"`
+	CreatedAt   string   `json:"created_at"`
+}
+
+type JSONHookEvent struct {
+	Event       string `json:"event"`
+	Category    string `json:"category"`
+	Description string `json:"description"`
 }
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
 
 	"github.com/spf13/cobra"
 	"github.com/novshi-tech/atl-cli/internal/auth"
@@ -56,7 +57,14 @@ func newJiraClient(cmd *cobra.Command) (*jira.Client, error) {
 		}
 	}
 
-	return jira.NewClientFromStore(store, site)
+	client, err := jira.NewClientFromStore(store, site)
+	if err != nil {
+		return nil, err
+	}
+	if dir, err := atlCacheDir(); err == nil {
+		client.SetUserCacheFile(filepath.Join(dir, "jira-users-"+site+".json"))
+	}
+	return client, nil
 }
 
 // newBitbucketClient resolves the site alias from the --site flag (or default) and returns a Bitbucket client.
@@ -118,6 +126,6 @@ func resolveBBWorkspace(cmd *cobra.Command) (string, error) {
 	case savedWS != "":
 		return savedWS, nil
 	default:
-		return "", fmt.Errorf("no workspace specified; use --workspace flag or configure it with 'atl configure --site <name>'")
+		return "", fmt.Errorf("no workspace specified; use --workspace flag or configure it with 'atl configure --site <name>' (see 'atl bitbucket workspace list')")
 	}
 }
@@ -2,6 +2,7 @@ package adf
 
 import (
 	"regexp"
+	"slices"
 	"strings"
 )
 
@@ -30,6 +31,7 @@ type Mark struct {
 //   - **bold**         → strong mark
 //   - *italic*        → em mark
 //   - [text](url)      → link mark
+//   - @[name:id]       → mention (see ResolveMentions for @[name])
 //   - blank lines      → empty paragraph
 func TextToADF(text string) Node {
 	lines := strings.Split(text, "\n")
@@ -312,6 +314,47 @@ func parseInline(text string) []Node {
 	return nodes
 }
 
+// bareMentionRe matches @[query] mentions that carry no accountId yet.
+var bareMentionRe = regexp.MustCompile(`@\[([^\]:]+)\]`)
+
+// ResolveMentions rewrites bare @[query] mentions in text into the
+// @[name:accountId] form that TextToADF turns into mention nodes. resolve
+// maps each distinct query (a name, email, etc.) to the user's display
+// name and account id. Mentions already in @[name:accountId] form, and
+// @[text](url) links, are left untouched.
+func ResolveMentions(text string, resolve func(query string) (displayName, accountID string, err error)) (string, error) {
+	locs := bareMentionRe.FindAllStringSubmatchIndex(text, -1)
+	if len(locs) == 0 {
+		return text, nil
+	}
+
+	resolved := make(map[string]string)
+	var b strings.Builder
+	pos := 0
+	for _, loc := range locs {
+		if loc[1] < len(text) && text[loc[1]] == '(' {
+			continue // a link whose text happens to start with @
+		}
+		query := strings.TrimSpace(text[loc[2]:loc[3]])
+		replacement, ok := resolved[query]
+		if !ok {
+			name, id, err := resolve(query)
+			if err != nil {
+				return "", err
+			}
+			// ':' and ']' would end the name early when parsed back.
+			name = strings.NewReplacer(":", "", "]", "").Replace(name)
+			replacement = "@[" + name + ":" + id + "]"
+			resolved[query] = replacement
+		}
+		b.WriteString(text[pos:loc[0]])
+		b.WriteString(replacement)
+		pos = loc[1]
+	}
+	b.WriteString(text[pos:])
+	return b.String(), nil
+}
+
 func sortMatches(matches []inlineMatch) {
 	for i := 1; i < len(matches); i++ {
 		for j := i; j > 0 && matches[j].start < matches[j-1].start; j-- {
@@ -319,3 +362,29 @@ func sortMatches(matches []inlineMatch) {
 		}
 	}
 }
+
+// RichContent returns the node and mark types in n other than doc,
+// paragraph and text, sorted and without duplicates. A document for which
+// it returns nothing is plain text and survives being edited as text.
+func RichContent(n *Node) []string {
+	var types []string
+	var walk func(n *Node)
+	walk = func(n *Node) {
+		switch n.Type {
+		case "doc", "paragraph", "text":
+		default:
+			types = append(types, n.Type)
+		}
+		for _, m := range n.Marks {
+			types = append(types, m.Type)
+		}
+		for i := range n.Content {
+			walk(&n.Content[i])
+		}
+	}
+	if n != nil {
+		walk(n)
+	}
+	slices.Sort(types)
+	return slices.Compact(types)
+}
@@ -2,6 +2,7 @@ package adf
 
 import (
 	"encoding/json"
+	"slices"
 	"testing"
 )
 
@@ -81,6 +82,48 @@ func TestTextToADF_ItalicInParagraph(t *testing.T) {
 	assertTextNode(t, para.Content[1], "important", []string{"em"})
 }
 
+func TestResolveMentions(t *testing.T) {
+	calls := 0
+	resolve := func(query string) (string, string, error) {
+		calls++
+		if query != "alice@example.com" {
+			t.Fatalf("unexpected query %q", query)
+		}
+		return "Alice: Admin", "557058:abc", nil
+	}
+	got, err := ResolveMentions("@[alice@example.com] and @[alice@example.com], not @[Bob:123] or @[link](http://x)", resolve)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "@[Alice Admin:557058:abc] and @[Alice Admin:557058:abc], not @[Bob:123] or @[link](http://x)"
+	if got != want {
+		t.Errorf("got  %q\nwant %q", got, want)
+	}
+	if calls != 1 {
+		t.Errorf("expected 1 resolve call, got %d", calls)
+	}
+
+	nodes := parseInline(got)
+	if nodes[0].Type != "mention" || nodes[0].Attrs["id"] != "557058:abc" {
+		t.Errorf("expected mention node for resolved id, got %s", jsonStr(nodes[0]))
+	}
+}
+
+func TestRichContent(t *testing.T) {
+	if got := RichContent(nil); len(got) != 0 {
+		t.Errorf("nil: expected nothing, got %v", got)
+	}
+	plain := TextToADF("first line\n\nsecond line")
+	if got := RichContent(&plain); len(got) != 0 {
+		t.Errorf("plain text: expected nothing, got %v", got)
+	}
+	rich := TextToADF("||h||\n|c|\n* item with **bold** and @[Alice:557058:abc]")
+	want := []string{"bulletList", "listItem", "mention", "strong", "table", "tableCell", "tableHeader", "tableRow"}
+	if got := RichContent(&rich); !slices.Equal(got, want) {
+		t.Errorf("got  %v\nwant %v", got, want)
+	}
+}
+
 // --- helpers ---
 
 func assertTextNode(t *testing.T, n Node, text string, markTypes []string) {
@@ -0,0 +1,37 @@
+package bitbucket
+
+import (
+	"fmt"
+	"time"
+)
+
+// ListPRActivity lists the activity on a pull request that happened after
+// since, in the order the API returns it (newest first). A zero since lists
+// all of it. The order isn't documented, so older entries are skipped rather
+// than ending the page, but paging stops at the first page with nothing newer.
+func (c *Client) ListPRActivity(workspace, repoSlug string, prID int, since time.Time) ([]PRActivity, error) {
+	url := fmt.Sprintf("%s/repositories/%s/%s/pullrequests/%d/activity?pagelen=50", baseURL, workspace, repoSlug, prID)
+	var all []PRActivity
+	for url != "" {
+		var p page[PRActivity]
+		if err := c.doRequestURL("GET", url, nil, &p); err != nil {
+			return nil, err
+		}
+		newer := false
+		for _, a := range p.Values {
+			if !since.IsZero() {
+				t, err := time.Parse(time.RFC3339Nano, a.Date())
+				if err == nil && !t.After(since) {
+					continue
+				}
+			}
+			all = append(all, a)
+			newer = true
+		}
+		if !since.IsZero() && !newer {
+			break
+		}
+		url = p.Next
+	}
+	return all, nil
+}
@@ -0,0 +1,43 @@
+package bitbucket
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
+
+func TestListPRActivity_StopsAtPageWithNothingNewer(t *testing.T) {
+	pages := []string{
+		`{"values":[{"approval":{"date":"2024-06-03T10:00:00+00:00"}},{"approval":{"date":"2024-06-01T10:00:00+00:00"}}],"next":"https://example.test/page/1"}`,
+		`{"values":[{"approval":{"date":"2024-05-31T10:00:00+00:00"}},{"approval":{"date":"2024-05-30T10:00:00+00:00"}}],"next":"https://example.test/page/2"}`,
+		`{"values":[{"approval":{"date":"2024-06-05T10:00:00+00:00"}}]}`,
+	}
+	requests := 0
+	c := &Client{httpClient: &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		if requests >= len(pages) {
+			return nil, fmt.Errorf("unexpected request %s", r.URL)
+		}
+		body := pages[requests]
+		requests++
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body)), Header: http.Header{}}, nil
+	})}}
+
+	since := time.Date(2024, 6, 2, 0, 0, 0, 0, time.UTC)
+	activity, err := c.ListPRActivity("ws", "repo", 1, since)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if requests != 2 {
+		t.Errorf("requests = %d, want 2", requests)
+	}
+	if len(activity) != 1 || activity[0].Date() != "2024-06-03T10:00:00+00:00" {
+		t.Errorf("activity = %+v, want only the 2024-06-03 entry", activity)
+	}
+}
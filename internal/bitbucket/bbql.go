@@ -0,0 +1,137 @@
+package bitbucket
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// PRStates lists the pull request states accepted by the API.
+var PRStates = []string{"OPEN", "MERGED", "DECLINED", "SUPERSEDED"}
+
+// PRFilter describes a pull request search. Users are given by UUID;
+// branch names may contain glob wildcards (see MatchBranch).
+type PRFilter struct {
+	States       []string
+	AuthorUUID   string
+	ReviewerUUID string
+	Source       string
+	Dest         string
+	Query        string
+	// WithParticipants asks for each pull request's participants, which
+	// lists leave out by default.
+	WithParticipants bool
+}
+
+// BuildPRQuery turns f into a BBQL expression for the q= parameter, or ""
+// if f has no criteria besides States (which are passed as separate state=
+// parameters). BBQL has no wildcard operator, so a branch glob becomes a
+// case-insensitive "contains" match on its leading literal part, and
+// callers narrow the results down with MatchBranch.
+func BuildPRQuery(f PRFilter) string {
+	var clauses []string
+	if f.AuthorUUID != "" {
+		clauses = append(clauses, "author.uuid = "+bbqlQuote(f.AuthorUUID))
+	}
+	if f.ReviewerUUID != "" {
+		clauses = append(clauses, "reviewers.uuid = "+bbqlQuote(f.ReviewerUUID))
+	}
+	if c := branchClause("source.branch.name", f.Source); c != "" {
+		clauses = append(clauses, c)
+	}
+	if c := branchClause("destination.branch.name", f.Dest); c != "" {
+		clauses = append(clauses, c)
+	}
+	if f.Query != "" {
+		if len(clauses) > 0 {
+			clauses = append(clauses, "("+f.Query+")")
+		} else {
+			clauses = append(clauses, f.Query)
+		}
+	}
+	return strings.Join(clauses, " AND ")
+}
+
+func branchClause(field, pattern string) string {
+	if pattern == "" {
+		return ""
+	}
+	i := strings.IndexAny(pattern, "*?[")
+	if i < 0 {
+		return field + " = " + bbqlQuote(pattern)
+	}
+	if i == 0 {
+		return ""
+	}
+	return field + " ~ " + bbqlQuote(pattern[:i])
+}
+
+// MatchBranch reports whether a pull request's source and destination
+// branches match the (possibly glob) patterns in f.
+func (f PRFilter) MatchBranch(pr PullRequest) bool {
+	return matchBranch(f.Source, pr.Source.Branch.Name) && matchBranch(f.Dest, pr.Destination.Branch.Name)
+}
+
+func matchBranch(pattern, name string) bool {
+	if pattern == "" {
+		return true
+	}
+	ok, _ := path.Match(pattern, name)
+	return ok
+}
+
+// RepoRoles lists the values accepted by the role= parameter when listing
+// repositories.
+var RepoRoles = []string{"member", "contributor", "admin", "owner"}
+
+// RepoFilter describes a repository search. Role limits the results to
+// repositories on which the current user has at least that role.
+type RepoFilter struct {
+	Project string
+	Role    string
+	Query   string
+}
+
+// BuildRepoQuery turns f into a BBQL expression for the q= parameter, or
+// "" if f has no criteria besides Role.
+func BuildRepoQuery(f RepoFilter) string {
+	var clauses []string
+	if f.Project != "" {
+		clauses = append(clauses, "project.key = "+bbqlQuote(f.Project))
+	}
+	if f.Query != "" {
+		if len(clauses) > 0 {
+			clauses = append(clauses, "("+f.Query+")")
+		} else {
+			clauses = append(clauses, f.Query)
+		}
+	}
+	return strings.Join(clauses, " AND ")
+}
+
+// BuildRefQuery turns a branch or tag name filter into a BBQL expression.
+// A plain pattern matches names containing it; a glob must match the whole
+// name, and is sent as a "contains" match on its leading literal part for
+// callers to narrow down with MatchRefName.
+func BuildRefQuery(pattern string) string {
+	if pattern == "" {
+		return ""
+	}
+	if !strings.ContainsAny(pattern, "*?[") {
+		return "name ~ " + bbqlQuote(pattern)
+	}
+	return branchClause("name", pattern)
+}
+
+// MatchRefName reports whether name matches a filter given to
+// BuildRefQuery. Plain patterns are left to the server.
+func MatchRefName(pattern, name string) bool {
+	if !strings.ContainsAny(pattern, "*?[") {
+		return true
+	}
+	return matchBranch(pattern, name)
+}
+
+func bbqlQuote(s string) string {
+	return fmt.Sprintf("%q", s)
+}
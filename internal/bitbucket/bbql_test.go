@@ -0,0 +1,64 @@
+package bitbucket
+
+import "testing"
+
+func TestBuildPRQuery(t *testing.T) {
+	got := BuildPRQuery(PRFilter{
+		AuthorUUID:   "{abc}",
+		ReviewerUUID: "{def}",
+		Source:       "feature/*",
+		Dest:         "main",
+		Query:        `title ~ "fix" OR title ~ "bug"`,
+	})
+	want := `author.uuid = "{abc}" AND reviewers.uuid = "{def}" AND source.branch.name ~ "feature/" AND destination.branch.name = "main" AND (title ~ "fix" OR title ~ "bug")`
+	if got != want {
+		t.Errorf("got  %s\nwant %s", got, want)
+	}
+}
+
+func TestBuildPRQuery_Empty(t *testing.T) {
+	if got := BuildPRQuery(PRFilter{States: []string{"OPEN"}, Source: "*-hotfix"}); got != "" {
+		t.Errorf("got %q, want empty query", got)
+	}
+}
+
+func TestPRFilterMatchBranch(t *testing.T) {
+	f := PRFilter{Source: "feature/*", Dest: "main"}
+	pr := PullRequest{Source: PRRef{Branch: Branch{Name: "feature/auth"}}, Destination: PRRef{Branch: Branch{Name: "main"}}}
+	if !f.MatchBranch(pr) {
+		t.Error("expected feature/auth→main to match")
+	}
+	pr.Source.Branch.Name = "bugfix/feature/auth"
+	if f.MatchBranch(pr) {
+		t.Error("expected bugfix/feature/auth→main not to match")
+	}
+}
+
+func TestBuildRefQuery(t *testing.T) {
+	tests := map[string]string{
+		"":            "",
+		"auth":        `name ~ "auth"`,
+		"feature/*":   `name ~ "feature/"`,
+		"*-hotfix":    "",
+		"release/1.?": `name ~ "release/1."`,
+	}
+	for pattern, want := range tests {
+		if got := BuildRefQuery(pattern); got != want {
+			t.Errorf("BuildRefQuery(%q) = %q, want %q", pattern, got, want)
+		}
+	}
+	if MatchRefName("feature/*", "bugfix/feature/x") {
+		t.Error("expected glob to match the whole name")
+	}
+	if !MatchRefName("auth", "feature/auth") {
+		t.Error("expected plain pattern to be left to the server")
+	}
+}
+
+func TestBuildRepoQuery(t *testing.T) {
+	got := BuildRepoQuery(RepoFilter{Project: "APP", Role: "admin", Query: `name ~ "api" OR name ~ "web"`})
+	want := `project.key = "APP" AND (name ~ "api" OR name ~ "web")`
+	if got != want {
+		t.Errorf("got  %s\nwant %s", got, want)
+	}
+}
@@ -6,11 +6,17 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strings"
+	"time"
 
 	"github.com/novshi-tech/atl-cli/internal/auth"
 )
 
-const baseURL = "https://api.bitbucket.org/2.0"
+const (
+	apiHost = "https://api.bitbucket.org"
+	baseURL = apiHost + "/2.0"
+)
 
 // Client is an HTTP client for the Bitbucket Cloud REST API 2.0.
 type Client struct {
@@ -51,14 +57,38 @@ func (c *Client) GetCurrentUser() (*BBUser, error) {
 	return &resp, nil
 }
 
-// ListRepositories lists repositories for a workspace.
-func (c *Client) ListRepositories(workspace string, page, pagelen int) (*RepositoriesResponse, error) {
-	path := fmt.Sprintf("/repositories/%s?page=%d&pagelen=%d", workspace, page, pagelen)
-	var resp RepositoriesResponse
-	if err := c.doRequest("GET", path, nil, &resp); err != nil {
-		return nil, err
+// ListRepositories lists the repositories of a workspace that match f,
+// following pagination until max results (0 for all of them).
+func (c *Client) ListRepositories(workspace string, f RepoFilter, max int) ([]Repository, error) {
+	params := url.Values{}
+	if q := BuildRepoQuery(f); q != "" {
+		params.Set("q", q)
 	}
-	return &resp, nil
+	if f.Role != "" {
+		params.Set("role", f.Role)
+	}
+	params.Set("pagelen", "100")
+	next := fmt.Sprintf("%s/repositories/%s?%s", baseURL, workspace, params.Encode())
+
+	var all []Repository
+	for next != "" {
+		var p page[Repository]
+		if err := c.doRequestURL("GET", next, nil, &p); err != nil {
+			return nil, err
+		}
+		all = append(all, p.Values...)
+		if max > 0 && len(all) >= max {
+			return all[:max], nil
+		}
+		next = p.Next
+	}
+	return all, nil
+}
+
+// ListAllRepositories lists every repository in a workspace.
+func (c *Client) ListAllRepositories(workspace string) ([]Repository, error) {
+	path := fmt.Sprintf("/repositories/%s?pagelen=100", workspace)
+	return listAll[Repository](c, baseURL+path)
 }
 
 // GetRepository retrieves a single repository.
@@ -71,19 +101,131 @@ func (c *Client) GetRepository(workspace, repoSlug string) (*Repository, error)
 	return &resp, nil
 }
 
-// ListPullRequests lists pull requests for a repository.
-func (c *Client) ListPullRequests(workspace, repoSlug, state string, page, pagelen int) (*PullRequestsResponse, error) {
-	path := fmt.Sprintf("/repositories/%s/%s/pullrequests?page=%d&pagelen=%d", workspace, repoSlug, page, pagelen)
-	if state != "" {
-		path += "&state=" + state
+// CreateRepository creates a repository with the given slug.
+func (c *Client) CreateRepository(workspace, repoSlug string, req CreateRepositoryRequest) (*Repository, error) {
+	path := fmt.Sprintf("/repositories/%s/%s", workspace, repoSlug)
+	var resp Repository
+	if err := c.doRequest("POST", path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ForkRepository forks a repository, by default into the same workspace.
+func (c *Client) ForkRepository(workspace, repoSlug string, req ForkRepositoryRequest) (*Repository, error) {
+	path := fmt.Sprintf("/repositories/%s/%s/forks", workspace, repoSlug)
+	var resp Repository
+	if err := c.doRequest("POST", path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// UpdateRepository changes the settings of a repository.
+func (c *Client) UpdateRepository(workspace, repoSlug string, req UpdateRepositoryRequest) (*Repository, error) {
+	path := fmt.Sprintf("/repositories/%s/%s", workspace, repoSlug)
+	var resp Repository
+	if err := c.doRequest("PUT", path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DeleteRepository deletes a repository.
+func (c *Client) DeleteRepository(workspace, repoSlug string) error {
+	path := fmt.Sprintf("/repositories/%s/%s", workspace, repoSlug)
+	return c.doRequest("DELETE", path, nil, nil)
+}
+
+// ListPullRequests lists the pull requests of a repository that match f,
+// most recently updated first, following pagination until max results
+// (0 for all of them).
+func (c *Client) ListPullRequests(workspace, repoSlug string, f PRFilter, max int) ([]PullRequest, error) {
+	params := url.Values{}
+	for _, s := range f.States {
+		params.Add("state", s)
+	}
+	if q := BuildPRQuery(f); q != "" {
+		params.Set("q", q)
+	}
+	if f.WithParticipants {
+		params.Set("fields", "+values.participants")
 	}
-	var resp PullRequestsResponse
+	params.Set("sort", "-updated_on")
+	params.Set("pagelen", "50")
+	next := fmt.Sprintf("%s/repositories/%s/%s/pullrequests?%s", baseURL, workspace, repoSlug, params.Encode())
+
+	var all []PullRequest
+	for next != "" {
+		var p page[PullRequest]
+		if err := c.doRequestURL("GET", next, nil, &p); err != nil {
+			return nil, err
+		}
+		for _, pr := range p.Values {
+			if !f.MatchBranch(pr) {
+				continue
+			}
+			all = append(all, pr)
+			if max > 0 && len(all) >= max {
+				return all, nil
+			}
+		}
+		next = p.Next
+	}
+	return all, nil
+}
+
+// GetPullRequest retrieves a single pull request.
+func (c *Client) GetPullRequest(workspace, repoSlug string, prID int) (*PullRequest, error) {
+	path := fmt.Sprintf("/repositories/%s/%s/pullrequests/%d", workspace, repoSlug, prID)
+	var resp PullRequest
 	if err := c.doRequest("GET", path, nil, &resp); err != nil {
 		return nil, err
 	}
 	return &resp, nil
 }
 
+// ListCommitStatuses lists all statuses (builds etc.) reported against a
+// commit.
+func (c *Client) ListCommitStatuses(workspace, repoSlug, commit string) ([]CommitStatus, error) {
+	path := fmt.Sprintf("/repositories/%s/%s/commit/%s/statuses?pagelen=100", workspace, repoSlug, commit)
+	return listAll[CommitStatus](c, baseURL+path)
+}
+
+// GetPRDiff returns the unified diff of a pull request.
+func (c *Client) GetPRDiff(workspace, repoSlug string, prID int) (string, error) {
+	path := fmt.Sprintf("/repositories/%s/%s/pullrequests/%d/diff", workspace, repoSlug, prID)
+	_, body, err := c.sendWithHeader("GET", baseURL+path, nil, http.Header{"Accept": {"text/plain"}})
+	if err != nil {
+		return "", err
+	}
+	return string(body), nil
+}
+
+// ListPRDiffStat lists the files changed by a pull request with their
+// line counts.
+func (c *Client) ListPRDiffStat(workspace, repoSlug string, prID int) ([]DiffStat, error) {
+	path := fmt.Sprintf("/repositories/%s/%s/pullrequests/%d/diffstat?pagelen=500", workspace, repoSlug, prID)
+	return listAll[DiffStat](c, baseURL+path)
+}
+
+// ListPRStatuses lists all statuses reported against the commits of a pull
+// request.
+func (c *Client) ListPRStatuses(workspace, repoSlug string, prID int) ([]CommitStatus, error) {
+	path := fmt.Sprintf("/repositories/%s/%s/pullrequests/%d/statuses?pagelen=100", workspace, repoSlug, prID)
+	return listAll[CommitStatus](c, baseURL+path)
+}
+
+// SetCommitStatus creates or replaces a build status on a commit.
+func (c *Client) SetCommitStatus(workspace, repoSlug, commit string, req SetCommitStatusRequest) (*CommitStatus, error) {
+	path := fmt.Sprintf("/repositories/%s/%s/commit/%s/statuses/build", workspace, repoSlug, commit)
+	var resp CommitStatus
+	if err := c.doRequest("POST", path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 // CreatePullRequest creates a new pull request.
 func (c *Client) CreatePullRequest(workspace, repoSlug string, req CreatePRRequest) (*PullRequest, error) {
 	path := fmt.Sprintf("/repositories/%s/%s/pullrequests", workspace, repoSlug)
@@ -94,6 +236,24 @@ func (c *Client) CreatePullRequest(workspace, repoSlug string, req CreatePRReque
 	return &resp, nil
 }
 
+// UpdatePullRequest updates a pull request.
+func (c *Client) UpdatePullRequest(workspace, repoSlug string, prID int, req UpdatePRRequest) (*PullRequest, error) {
+	path := fmt.Sprintf("/repositories/%s/%s/pullrequests/%d", workspace, repoSlug, prID)
+	var resp PullRequest
+	if err := c.doRequest("PUT", path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListEffectiveDefaultReviewers lists the default reviewers that apply to
+// new pull requests in a repository, including those inherited from its
+// project.
+func (c *Client) ListEffectiveDefaultReviewers(workspace, repoSlug string) ([]DefaultReviewer, error) {
+	path := fmt.Sprintf("/repositories/%s/%s/effective-default-reviewers?pagelen=100", workspace, repoSlug)
+	return listAll[DefaultReviewer](c, baseURL+path)
+}
+
 // ListPRComments lists all comments on a pull request, following pagination
 // until every page has been fetched (the API caps each page at 10 comments).
 func (c *Client) ListPRComments(workspace, repoSlug string, prID int) (*PRCommentsResponse, error) {
@@ -140,6 +300,135 @@ func (c *Client) DeletePRComment(workspace, repoSlug string, prID, commentID int
 	return c.doRequest("DELETE", path, nil, nil)
 }
 
+// UpdatePRComment replaces the body of a comment on a pull request.
+func (c *Client) UpdatePRComment(workspace, repoSlug string, prID, commentID int, raw string) (*PRComment, error) {
+	path := fmt.Sprintf("/repositories/%s/%s/pullrequests/%d/comments/%d", workspace, repoSlug, prID, commentID)
+	body := struct {
+		Content PRCommentContent `json:"content"`
+	}{PRCommentContent{Raw: raw}}
+	var resp PRComment
+	if err := c.doRequest("PUT", path, body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ResolvePRComment resolves the comment thread rooted at commentID.
+func (c *Client) ResolvePRComment(workspace, repoSlug string, prID, commentID int) error {
+	path := fmt.Sprintf("/repositories/%s/%s/pullrequests/%d/comments/%d/resolve", workspace, repoSlug, prID, commentID)
+	return c.doRequest("POST", path, nil, nil)
+}
+
+// ReopenPRComment reopens a resolved comment thread.
+func (c *Client) ReopenPRComment(workspace, repoSlug string, prID, commentID int) error {
+	path := fmt.Sprintf("/repositories/%s/%s/pullrequests/%d/comments/%d/resolve", workspace, repoSlug, prID, commentID)
+	return c.doRequest("DELETE", path, nil, nil)
+}
+
+// ApprovePullRequest approves a pull request as the authenticated user.
+func (c *Client) ApprovePullRequest(workspace, repoSlug string, prID int) error {
+	path := fmt.Sprintf("/repositories/%s/%s/pullrequests/%d/approve", workspace, repoSlug, prID)
+	return c.doRequest("POST", path, nil, nil)
+}
+
+// UnapprovePullRequest withdraws the authenticated user's approval.
+func (c *Client) UnapprovePullRequest(workspace, repoSlug string, prID int) error {
+	path := fmt.Sprintf("/repositories/%s/%s/pullrequests/%d/approve", workspace, repoSlug, prID)
+	return c.doRequest("DELETE", path, nil, nil)
+}
+
+// RequestChanges marks the pull request as needing changes by the
+// authenticated user.
+func (c *Client) RequestChanges(workspace, repoSlug string, prID int) error {
+	path := fmt.Sprintf("/repositories/%s/%s/pullrequests/%d/request-changes", workspace, repoSlug, prID)
+	return c.doRequest("POST", path, nil, nil)
+}
+
+// RemoveChangeRequest withdraws the authenticated user's change request.
+func (c *Client) RemoveChangeRequest(workspace, repoSlug string, prID int) error {
+	path := fmt.Sprintf("/repositories/%s/%s/pullrequests/%d/request-changes", workspace, repoSlug, prID)
+	return c.doRequest("DELETE", path, nil, nil)
+}
+
+// DeclinePullRequest declines a pull request.
+func (c *Client) DeclinePullRequest(workspace, repoSlug string, prID int) (*PullRequest, error) {
+	path := fmt.Sprintf("/repositories/%s/%s/pullrequests/%d/decline", workspace, repoSlug, prID)
+	var resp PullRequest
+	if err := c.doRequest("POST", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// mergePollInterval is how often MergePullRequest polls an async merge task.
+const mergePollInterval = 2 * time.Second
+
+// MergePullRequest merges a pull request and returns the merged pull
+// request. The merge is requested asynchronously: when Bitbucket answers
+// 202 Accepted, the merge task status is polled until it finishes or
+// timeout elapses.
+func (c *Client) MergePullRequest(workspace, repoSlug string, prID int, req MergePRRequest, timeout time.Duration) (*PullRequest, error) {
+	path := fmt.Sprintf("/repositories/%s/%s/pullrequests/%d/merge?async=true", workspace, repoSlug, prID)
+	req.Type = "pullrequest"
+	resp, body, err := c.send("POST", baseURL+path, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusAccepted {
+		var pr PullRequest
+		if err := json.Unmarshal(body, &pr); err != nil {
+			return nil, fmt.Errorf("unmarshaling response: %w", err)
+		}
+		return &pr, nil
+	}
+
+	taskURL := resp.Header.Get("Location")
+	if taskURL == "" {
+		return nil, fmt.Errorf("merge accepted but no task status location returned")
+	}
+	if strings.HasPrefix(taskURL, "/") {
+		taskURL = apiHost + taskURL
+	}
+
+	deadline := time.Now().Add(timeout)
+	for {
+		var status MergeTaskStatus
+		if err := c.doRequestURL("GET", taskURL, nil, &status); err != nil {
+			return nil, fmt.Errorf("polling merge status: %w", err)
+		}
+		switch status.TaskStatus {
+		case "SUCCESS":
+			if status.MergeResult == nil {
+				return nil, fmt.Errorf("merge finished but returned no result")
+			}
+			return status.MergeResult, nil
+		case "PENDING":
+		default:
+			return nil, fmt.Errorf("merge failed with task status %q", status.TaskStatus)
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("timed out after %s waiting for merge to finish; check %s", timeout, taskURL)
+		}
+		time.Sleep(mergePollInterval)
+	}
+}
+
+// listAll fetches every page of a paginated collection starting at url,
+// following the "next" links until the last page.
+func listAll[T any](c *Client, url string) ([]T, error) {
+	var all []T
+	for url != "" {
+		var p page[T]
+		if err := c.doRequestURL("GET", url, nil, &p); err != nil {
+			return nil, err
+		}
+		all = append(all, p.Values...)
+		url = p.Next
+	}
+	return all, nil
+}
+
 func (c *Client) doRequest(method, path string, body any, result any) error {
 	return c.doRequestURL(method, baseURL+path, body, result)
 }
@@ -147,46 +436,83 @@ func (c *Client) doRequest(method, path string, body any, result any) error {
 // doRequestURL is like doRequest but takes a fully-qualified URL, for
 // following pagination links returned by the API (e.g. PRCommentsResponse.Next).
 func (c *Client) doRequestURL(method, url string, body any, result any) error {
+	_, respBody, err := c.send(method, url, body)
+	if err != nil {
+		return err
+	}
+	if result != nil && len(respBody) > 0 {
+		if err := json.Unmarshal(respBody, result); err != nil {
+			return fmt.Errorf("unmarshaling response: %w", err)
+		}
+	}
+	return nil
+}
+
+// send performs the request and returns the response (whose body has
+// already been read and closed) along with the body. Non-2xx responses are
+// returned as errors. Callers that need status codes or headers (e.g. the
+// Location of an async task) use this instead of doRequestURL.
+func (c *Client) send(method, url string, body any) (*http.Response, []byte, error) {
+	return c.sendWithHeader(method, url, body, nil)
+}
+
+// sendWithHeader is send with extra request headers, which override the
+// defaults: e.g. Accept for endpoints that return plain text, or Range.
+func (c *Client) sendWithHeader(method, url string, body any, header http.Header) (*http.Response, []byte, error) {
+	resp, err := c.open(method, url, body, header)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading response: %w", err)
+	}
+	return resp, respBody, nil
+}
+
+// open performs the request and returns the response with its body still
+// open, for callers that stream it (e.g. file downloads); they must close
+// it. Non-2xx responses are returned as errors.
+func (c *Client) open(method, url string, body any, header http.Header) (*http.Response, error) {
 	var bodyReader io.Reader
 	if body != nil {
 		data, err := json.Marshal(body)
 		if err != nil {
-			return fmt.Errorf("marshaling request: %w", err)
+			return nil, fmt.Errorf("marshaling request: %w", err)
 		}
 		bodyReader = bytes.NewReader(data)
 	}
 
 	req, err := http.NewRequest(method, url, bodyReader)
 	if err != nil {
-		return fmt.Errorf("creating request: %w", err)
+		return nil, fmt.Errorf("creating request: %w", err)
 	}
 	req.SetBasicAuth(c.email, c.apiToken)
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return fmt.Errorf("executing request: %w", err)
+	for k, v := range header {
+		req.Header[k] = v
 	}
-	defer resp.Body.Close()
 
-	respBody, err := io.ReadAll(resp.Body)
+	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("reading response: %w", err)
+		return nil, fmt.Errorf("executing request: %w", err)
 	}
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		defer resp.Body.Close()
+		respBody, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("reading response: %w", err)
+		}
 		var apiErr APIError
 		if json.Unmarshal(respBody, &apiErr) == nil && apiErr.String() != "" {
-			return fmt.Errorf("bitbucket API error (%d): %s", resp.StatusCode, apiErr.String())
+			return nil, &StatusError{StatusCode: resp.StatusCode, Message: apiErr.String()}
 		}
-		return fmt.Errorf("bitbucket API error (%d): %s", resp.StatusCode, string(respBody))
+		return nil, &StatusError{StatusCode: resp.StatusCode, Message: string(respBody)}
 	}
 
-	if result != nil && len(respBody) > 0 {
-		if err := json.Unmarshal(respBody, result); err != nil {
-			return fmt.Errorf("unmarshaling response: %w", err)
-		}
-	}
-	return nil
+	return resp, nil
 }
@@ -0,0 +1,89 @@
+package bitbucket
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// ListCommits lists the commits reachable from revision (a branch, tag or
+// commit hash), newest first, optionally limited to those touching path
+// and to those authored at or after since (if set), stopping after max
+// results (0 for all). Author dates are not monotonic in topological
+// order (rebases, merges of old branches), so older commits are skipped
+// rather than ending the listing, which stops instead at the first page
+// with no commit at or after since.
+func (c *Client) ListCommits(workspace, repoSlug, revision, path string, since time.Time, max int) ([]Commit, error) {
+	params := url.Values{}
+	if path != "" {
+		params.Set("path", path)
+	}
+	params.Set("pagelen", "100")
+	next := fmt.Sprintf("%s/repositories/%s/%s/commits/%s?%s", baseURL, workspace, repoSlug, url.PathEscape(revision), params.Encode())
+
+	var all []Commit
+	for next != "" {
+		var p page[Commit]
+		if err := c.doRequestURL("GET", next, nil, &p); err != nil {
+			return nil, err
+		}
+		newer := false
+		for _, commit := range p.Values {
+			if !since.IsZero() {
+				if t, err := time.Parse(time.RFC3339Nano, commit.Date); err == nil && t.Before(since) {
+					continue
+				}
+			}
+			all = append(all, commit)
+			newer = true
+			if max > 0 && len(all) >= max {
+				return all, nil
+			}
+		}
+		if !since.IsZero() && !newer {
+			break
+		}
+		next = p.Next
+	}
+	return all, nil
+}
+
+// GetCommit retrieves a commit by hash (or any revision the API accepts).
+func (c *Client) GetCommit(workspace, repoSlug, revision string) (*Commit, error) {
+	path := fmt.Sprintf("/repositories/%s/%s/commit/%s", workspace, repoSlug, url.PathEscape(revision))
+	var resp Commit
+	if err := c.doRequest("GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListCommitDiffStat lists the files changed by a commit relative to its
+// first parent.
+func (c *Client) ListCommitDiffStat(workspace, repoSlug, revision string) ([]DiffStat, error) {
+	path := fmt.Sprintf("/repositories/%s/%s/diffstat/%s?pagelen=500", workspace, repoSlug, url.PathEscape(revision))
+	return listAll[DiffStat](c, baseURL+path)
+}
+
+// ListPRCommits lists the commits of a pull request, newest first.
+func (c *Client) ListPRCommits(workspace, repoSlug string, prID int) ([]Commit, error) {
+	path := fmt.Sprintf("/repositories/%s/%s/pullrequests/%d/commits?pagelen=100", workspace, repoSlug, prID)
+	return listAll[Commit](c, baseURL+path)
+}
+
+// ListCommitComments lists the comments on a commit. They have the same
+// shape as pull request comments.
+func (c *Client) ListCommitComments(workspace, repoSlug, commit string) ([]PRComment, error) {
+	path := fmt.Sprintf("/repositories/%s/%s/commit/%s/comments?pagelen=100", workspace, repoSlug, url.PathEscape(commit))
+	return listAll[PRComment](c, baseURL+path)
+}
+
+// CreateCommitComment creates a comment on a commit.
+func (c *Client) CreateCommitComment(workspace, repoSlug, commit string, req CreatePRCommentRequest) (*PRComment, error) {
+	path := fmt.Sprintf("/repositories/%s/%s/commit/%s/comments", workspace, repoSlug, url.PathEscape(commit))
+	var resp PRComment
+	if err := c.doRequest("POST", path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
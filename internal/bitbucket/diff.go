@@ -0,0 +1,87 @@
+package bitbucket
+
+import (
+	"path"
+	"strings"
+)
+
+// FileDiff is the part of a unified diff that concerns a single file.
+type FileDiff struct {
+	OldPath string
+	NewPath string
+	Text    string
+}
+
+// Path returns the file's new path, or its old path if it was deleted.
+func (f FileDiff) Path() string {
+	if f.NewPath != "" {
+		return f.NewPath
+	}
+	return f.OldPath
+}
+
+// SplitDiff splits a git-style unified diff into per-file sections, each
+// starting at its "diff --git" line. Paths come from the "---"/"+++"
+// headers, falling back to the "diff --git" line for diffs without hunks
+// (binary files, pure renames, mode changes).
+func SplitDiff(diff string) []FileDiff {
+	var files []FileDiff
+	var cur *FileDiff
+	var text strings.Builder
+	flush := func() {
+		if cur != nil {
+			cur.Text = text.String()
+			files = append(files, *cur)
+		}
+		text.Reset()
+	}
+
+	for _, line := range strings.SplitAfter(diff, "\n") {
+		if line == "" {
+			continue
+		}
+		trimmed := strings.TrimRight(line, "\r\n")
+		switch {
+		case strings.HasPrefix(trimmed, "diff --git "):
+			flush()
+			cur = &FileDiff{}
+			if a, b, ok := strings.Cut(strings.TrimPrefix(trimmed, "diff --git "), " b/"); ok {
+				cur.OldPath = strings.TrimPrefix(a, "a/")
+				cur.NewPath = b
+			}
+		case cur != nil && strings.HasPrefix(trimmed, "--- "):
+			cur.OldPath = diffHeaderPath(trimmed[4:], "a/")
+		case cur != nil && strings.HasPrefix(trimmed, "+++ "):
+			cur.NewPath = diffHeaderPath(trimmed[4:], "b/")
+		}
+		if cur != nil {
+			text.WriteString(line)
+		}
+	}
+	flush()
+	return files
+}
+
+func diffHeaderPath(p, prefix string) string {
+	if p == "/dev/null" {
+		return ""
+	}
+	return strings.TrimPrefix(p, prefix)
+}
+
+// MatchPath reports whether file matches pattern. pattern is a glob as in
+// path.Match, matched against the full path; a pattern without a slash is
+// also matched against the base name, and a pattern naming a directory
+// matches everything below it.
+func MatchPath(pattern, file string) bool {
+	if ok, _ := path.Match(pattern, file); ok {
+		return true
+	}
+	if !strings.Contains(pattern, "/") {
+		if ok, _ := path.Match(pattern, path.Base(file)); ok {
+			return true
+		}
+	}
+	dir := strings.TrimSuffix(pattern, "/")
+	return dir != "" && strings.HasPrefix(file, dir+"/")
+}
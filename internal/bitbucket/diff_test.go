@@ -0,0 +1,58 @@
+package bitbucket
+
+import "testing"
+
+const sampleDiff = `diff --git a/src/auth.go b/src/auth.go
+index 1111111..2222222 100644
+--- a/src/auth.go
++++ b/src/auth.go
+@@ -1,2 +1,2 @@
+-old
++new
+diff --git a/docs/old.md b/docs/old.md
+deleted file mode 100644
+--- a/docs/old.md
++++ /dev/null
+@@ -1 +0,0 @@
+-gone
+diff --git a/logo.png b/logo.png
+Binary files differ
+`
+
+func TestSplitDiff(t *testing.T) {
+	files := SplitDiff(sampleDiff)
+	if len(files) != 3 {
+		t.Fatalf("got %d files, want 3", len(files))
+	}
+	want := []string{"src/auth.go", "docs/old.md", "logo.png"}
+	for i, f := range files {
+		if f.Path() != want[i] {
+			t.Errorf("file %d: got path %q, want %q", i, f.Path(), want[i])
+		}
+	}
+	if files[1].NewPath != "" {
+		t.Errorf("deleted file has new path %q", files[1].NewPath)
+	}
+	if files[0].Text != sampleDiff[:len(files[0].Text)] {
+		t.Errorf("first section does not start the diff: %q", files[0].Text)
+	}
+}
+
+func TestMatchPath(t *testing.T) {
+	tests := []struct {
+		pattern, file string
+		want          bool
+	}{
+		{"src/*.go", "src/auth.go", true},
+		{"*.go", "src/auth.go", true},
+		{"src", "src/auth.go", true},
+		{"src/", "src/auth.go", true},
+		{"docs/*.go", "src/auth.go", false},
+		{"sr", "src/auth.go", false},
+	}
+	for _, tt := range tests {
+		if got := MatchPath(tt.pattern, tt.file); got != tt.want {
+			t.Errorf("MatchPath(%q, %q) = %v, want %v", tt.pattern, tt.file, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package bitbucket
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// PermissionLevels lists the repository permission levels, lowest first.
+var PermissionLevels = []string{"read", "write", "admin"}
+
+// ListDefaultReviewers lists the users configured as default reviewers on
+// a repository itself (see ListEffectiveDefaultReviewers for those
+// inherited from its project too).
+func (c *Client) ListDefaultReviewers(workspace, repoSlug string) ([]PRUser, error) {
+	path := fmt.Sprintf("/repositories/%s/%s/default-reviewers?pagelen=100", workspace, repoSlug)
+	return listAll[PRUser](c, baseURL+path)
+}
+
+// AddDefaultReviewer adds a user, given by UUID, to the default reviewers
+// of a repository. Adding an existing default reviewer is a no-op.
+func (c *Client) AddDefaultReviewer(workspace, repoSlug, userUUID string) error {
+	path := fmt.Sprintf("/repositories/%s/%s/default-reviewers/%s", workspace, repoSlug, url.PathEscape(userUUID))
+	return c.doRequest("PUT", path, nil, nil)
+}
+
+// RemoveDefaultReviewer removes a user, given by UUID, from the default
+// reviewers of a repository.
+func (c *Client) RemoveDefaultReviewer(workspace, repoSlug, userUUID string) error {
+	path := fmt.Sprintf("/repositories/%s/%s/default-reviewers/%s", workspace, repoSlug, url.PathEscape(userUUID))
+	return c.doRequest("DELETE", path, nil, nil)
+}
+
+// ListRepoUserPermissions lists the explicit user permissions on a
+// repository.
+func (c *Client) ListRepoUserPermissions(workspace, repoSlug string) ([]RepoPermission, error) {
+	path := fmt.Sprintf("/repositories/%s/%s/permissions-config/users?pagelen=100", workspace, repoSlug)
+	return listAll[RepoPermission](c, baseURL+path)
+}
+
+// ListRepoGroupPermissions lists the explicit group permissions on a
+// repository.
+func (c *Client) ListRepoGroupPermissions(workspace, repoSlug string) ([]RepoPermission, error) {
+	path := fmt.Sprintf("/repositories/%s/%s/permissions-config/groups?pagelen=100", workspace, repoSlug)
+	return listAll[RepoPermission](c, baseURL+path)
+}
+
+// SetRepoUserPermission grants a user, given by UUID or account id, a
+// permission level on a repository, replacing any they already had.
+func (c *Client) SetRepoUserPermission(workspace, repoSlug, userID, level string) error {
+	path := fmt.Sprintf("/repositories/%s/%s/permissions-config/users/%s", workspace, repoSlug, url.PathEscape(userID))
+	return c.doRequest("PUT", path, map[string]string{"permission": level}, nil)
+}
+
+// DeleteRepoUserPermission revokes a user's explicit permission on a
+// repository.
+func (c *Client) DeleteRepoUserPermission(workspace, repoSlug, userID string) error {
+	path := fmt.Sprintf("/repositories/%s/%s/permissions-config/users/%s", workspace, repoSlug, url.PathEscape(userID))
+	return c.doRequest("DELETE", path, nil, nil)
+}
+
+// SetRepoGroupPermission grants a group a permission level on a
+// repository, replacing any it already had.
+func (c *Client) SetRepoGroupPermission(workspace, repoSlug, groupSlug, level string) error {
+	path := fmt.Sprintf("/repositories/%s/%s/permissions-config/groups/%s", workspace, repoSlug, url.PathEscape(groupSlug))
+	return c.doRequest("PUT", path, map[string]string{"permission": level}, nil)
+}
+
+// DeleteRepoGroupPermission revokes a group's explicit permission on a
+// repository.
+func (c *Client) DeleteRepoGroupPermission(workspace, repoSlug, groupSlug string) error {
+	path := fmt.Sprintf("/repositories/%s/%s/permissions-config/groups/%s", workspace, repoSlug, url.PathEscape(groupSlug))
+	return c.doRequest("DELETE", path, nil, nil)
+}
+
+// ListUserRepoPermissions lists a user's permissions on every repository
+// of a workspace, from the workspace permissions endpoint.
+func (c *Client) ListUserRepoPermissions(workspace, userUUID string) ([]RepoPermission, error) {
+	params := url.Values{}
+	params.Set("q", "user.uuid = "+bbqlQuote(userUUID))
+	params.Set("pagelen", "100")
+	return listAll[RepoPermission](c, fmt.Sprintf("%s/workspaces/%s/permissions/repositories?%s", baseURL, workspace, params.Encode()))
+}
@@ -0,0 +1,170 @@
+package bitbucket
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// ListPipelines lists the most recent pipelines of a repository, newest
+// first, up to max (0 for all).
+func (c *Client) ListPipelines(workspace, repoSlug string, max int) ([]Pipeline, error) {
+	next := fmt.Sprintf("%s/repositories/%s/%s/pipelines/?sort=-created_on&pagelen=50", baseURL, workspace, repoSlug)
+	var all []Pipeline
+	for next != "" {
+		var p page[Pipeline]
+		if err := c.doRequestURL("GET", next, nil, &p); err != nil {
+			return nil, err
+		}
+		all = append(all, p.Values...)
+		if max > 0 && len(all) >= max {
+			return all[:max], nil
+		}
+		next = p.Next
+	}
+	return all, nil
+}
+
+// GetPipeline retrieves a pipeline by UUID or build number.
+func (c *Client) GetPipeline(workspace, repoSlug, pipeline string) (*Pipeline, error) {
+	path := fmt.Sprintf("/repositories/%s/%s/pipelines/%s", workspace, repoSlug, url.PathEscape(pipeline))
+	var resp Pipeline
+	if err := c.doRequest("GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// RunPipeline triggers a pipeline.
+func (c *Client) RunPipeline(workspace, repoSlug string, req RunPipelineRequest) (*Pipeline, error) {
+	path := fmt.Sprintf("/repositories/%s/%s/pipelines/", workspace, repoSlug)
+	var resp Pipeline
+	if err := c.doRequest("POST", path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// StopPipeline stops a running pipeline.
+func (c *Client) StopPipeline(workspace, repoSlug, pipeline string) error {
+	path := fmt.Sprintf("/repositories/%s/%s/pipelines/%s/stopPipeline", workspace, repoSlug, url.PathEscape(pipeline))
+	return c.doRequest("POST", path, nil, nil)
+}
+
+// ListPipelineSteps lists the steps of a pipeline.
+func (c *Client) ListPipelineSteps(workspace, repoSlug, pipeline string) ([]PipelineStep, error) {
+	path := fmt.Sprintf("/repositories/%s/%s/pipelines/%s/steps/?pagelen=100", workspace, repoSlug, url.PathEscape(pipeline))
+	return listAll[PipelineStep](c, baseURL+path)
+}
+
+// GetPipelineStepLog returns a step's log from byte offset on. It returns
+// an empty log, rather than an error, when there is nothing at or past
+// offset yet, so that callers can poll a running step.
+func (c *Client) GetPipelineStepLog(workspace, repoSlug, pipeline, step string, offset int64) ([]byte, error) {
+	path := fmt.Sprintf("/repositories/%s/%s/pipelines/%s/steps/%s/log",
+		workspace, repoSlug, url.PathEscape(pipeline), url.PathEscape(step))
+	header := http.Header{"Accept": {"application/octet-stream"}}
+	if offset > 0 {
+		header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+	resp, body, err := c.sendWithHeader("GET", baseURL+path, nil, header)
+	if err != nil {
+		var se *StatusError
+		if errors.As(err, &se) && (se.StatusCode == http.StatusNotFound || se.StatusCode == http.StatusRequestedRangeNotSatisfiable) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	// A server that ignores Range sends the whole log; drop what the caller
+	// already has.
+	if offset > 0 && resp.StatusCode == http.StatusOK {
+		if int64(len(body)) <= offset {
+			return nil, nil
+		}
+		body = body[offset:]
+	}
+	return body, nil
+}
+
+// variablesPath returns the API path of the repository variables, or of a
+// deployment environment's variables when environmentUUID is set.
+func variablesPath(workspace, repoSlug, environmentUUID string) string {
+	if environmentUUID != "" {
+		return fmt.Sprintf("/repositories/%s/%s/deployments_config/environments/%s/variables",
+			workspace, repoSlug, url.PathEscape(environmentUUID))
+	}
+	return fmt.Sprintf("/repositories/%s/%s/pipelines_config/variables", workspace, repoSlug)
+}
+
+// ListPipelineVariables lists the repository variables, or a deployment
+// environment's variables when environmentUUID is set.
+func (c *Client) ListPipelineVariables(workspace, repoSlug, environmentUUID string) ([]PipelineVariableEntry, error) {
+	return listAll[PipelineVariableEntry](c, baseURL+variablesPath(workspace, repoSlug, environmentUUID)+"?pagelen=100")
+}
+
+// CreatePipelineVariable creates a repository or deployment variable.
+func (c *Client) CreatePipelineVariable(workspace, repoSlug, environmentUUID string, v PipelineVariableEntry) (*PipelineVariableEntry, error) {
+	var resp PipelineVariableEntry
+	if err := c.doRequest("POST", variablesPath(workspace, repoSlug, environmentUUID), v, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// UpdatePipelineVariable updates a repository or deployment variable.
+func (c *Client) UpdatePipelineVariable(workspace, repoSlug, environmentUUID string, v PipelineVariableEntry) (*PipelineVariableEntry, error) {
+	path := variablesPath(workspace, repoSlug, environmentUUID) + "/" + url.PathEscape(v.UUID)
+	var resp PipelineVariableEntry
+	if err := c.doRequest("PUT", path, v, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DeletePipelineVariable deletes a repository or deployment variable.
+func (c *Client) DeletePipelineVariable(workspace, repoSlug, environmentUUID, variableUUID string) error {
+	path := variablesPath(workspace, repoSlug, environmentUUID) + "/" + url.PathEscape(variableUUID)
+	return c.doRequest("DELETE", path, nil, nil)
+}
+
+// ListEnvironments lists a repository's deployment environments.
+func (c *Client) ListEnvironments(workspace, repoSlug string) ([]Environment, error) {
+	path := fmt.Sprintf("/repositories/%s/%s/environments/?pagelen=100", workspace, repoSlug)
+	return listAll[Environment](c, baseURL+path)
+}
+
+// ListPipelineSchedules lists a repository's scheduled pipelines.
+func (c *Client) ListPipelineSchedules(workspace, repoSlug string) ([]PipelineSchedule, error) {
+	path := fmt.Sprintf("/repositories/%s/%s/pipelines_config/schedules/?pagelen=100", workspace, repoSlug)
+	return listAll[PipelineSchedule](c, baseURL+path)
+}
+
+// CreatePipelineSchedule creates a scheduled pipeline.
+func (c *Client) CreatePipelineSchedule(workspace, repoSlug string, s PipelineSchedule) (*PipelineSchedule, error) {
+	path := fmt.Sprintf("/repositories/%s/%s/pipelines_config/schedules/", workspace, repoSlug)
+	s.Type = "pipeline_schedule"
+	var resp PipelineSchedule
+	if err := c.doRequest("POST", path, s, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DeletePipelineSchedule deletes a scheduled pipeline.
+func (c *Client) DeletePipelineSchedule(workspace, repoSlug, scheduleUUID string) error {
+	path := fmt.Sprintf("/repositories/%s/%s/pipelines_config/schedules/%s", workspace, repoSlug, url.PathEscape(scheduleUUID))
+	return c.doRequest("DELETE", path, nil, nil)
+}
+
+// ListPipelineCaches lists a repository's pipeline caches.
+func (c *Client) ListPipelineCaches(workspace, repoSlug string) ([]PipelineCache, error) {
+	path := fmt.Sprintf("/repositories/%s/%s/pipelines-config/caches/?pagelen=100", workspace, repoSlug)
+	return listAll[PipelineCache](c, baseURL+path)
+}
+
+// DeletePipelineCache deletes a pipeline cache.
+func (c *Client) DeletePipelineCache(workspace, repoSlug, cacheUUID string) error {
+	path := fmt.Sprintf("/repositories/%s/%s/pipelines-config/caches/%s", workspace, repoSlug, url.PathEscape(cacheUUID))
+	return c.doRequest("DELETE", path, nil, nil)
+}
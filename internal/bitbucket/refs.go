@@ -0,0 +1,101 @@
+package bitbucket
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// ListBranches lists the branches of a repository whose names match
+// pattern (see BuildRefQuery), ordered by sort (e.g. "name" or
+// "-target.date"; "" for the API default), up to max (0 for all).
+func (c *Client) ListBranches(workspace, repoSlug, pattern, sort string, max int) ([]Ref, error) {
+	return c.listRefs(workspace, repoSlug, "branches", pattern, sort, max)
+}
+
+// ListTags is ListBranches for tags.
+func (c *Client) ListTags(workspace, repoSlug, pattern, sort string, max int) ([]Ref, error) {
+	return c.listRefs(workspace, repoSlug, "tags", pattern, sort, max)
+}
+
+func (c *Client) listRefs(workspace, repoSlug, kind, pattern, sort string, max int) ([]Ref, error) {
+	params := url.Values{}
+	if q := BuildRefQuery(pattern); q != "" {
+		params.Set("q", q)
+	}
+	if sort != "" {
+		params.Set("sort", sort)
+	}
+	params.Set("pagelen", "100")
+	next := fmt.Sprintf("%s/repositories/%s/%s/refs/%s?%s", baseURL, workspace, repoSlug, kind, params.Encode())
+
+	var all []Ref
+	for next != "" {
+		var p page[Ref]
+		if err := c.doRequestURL("GET", next, nil, &p); err != nil {
+			return nil, err
+		}
+		for _, r := range p.Values {
+			if !MatchRefName(pattern, r.Name) {
+				continue
+			}
+			all = append(all, r)
+			if max > 0 && len(all) >= max {
+				return all, nil
+			}
+		}
+		next = p.Next
+	}
+	return all, nil
+}
+
+// GetBranch retrieves a branch by name.
+func (c *Client) GetBranch(workspace, repoSlug, name string) (*Ref, error) {
+	return c.getRef(workspace, repoSlug, "branches", name)
+}
+
+// GetTag retrieves a tag by name.
+func (c *Client) GetTag(workspace, repoSlug, name string) (*Ref, error) {
+	return c.getRef(workspace, repoSlug, "tags", name)
+}
+
+func (c *Client) getRef(workspace, repoSlug, kind, name string) (*Ref, error) {
+	path := fmt.Sprintf("/repositories/%s/%s/refs/%s/%s", workspace, repoSlug, kind, url.PathEscape(name))
+	var resp Ref
+	if err := c.doRequest("GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// CreateBranch creates a branch pointing at target (a commit hash or
+// branch name).
+func (c *Client) CreateBranch(workspace, repoSlug, name, target string) (*Ref, error) {
+	return c.createRef(workspace, repoSlug, "branches", CreateRefRequest{Name: name, Target: PRCommit{Hash: target}})
+}
+
+// CreateTag creates a tag pointing at target. A non-empty message makes it
+// an annotated tag.
+func (c *Client) CreateTag(workspace, repoSlug, name, target, message string) (*Ref, error) {
+	return c.createRef(workspace, repoSlug, "tags", CreateRefRequest{Name: name, Target: PRCommit{Hash: target}, Message: message})
+}
+
+func (c *Client) createRef(workspace, repoSlug, kind string, req CreateRefRequest) (*Ref, error) {
+	path := fmt.Sprintf("/repositories/%s/%s/refs/%s", workspace, repoSlug, kind)
+	var resp Ref
+	if err := c.doRequest("POST", path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DeleteBranch deletes a branch.
+func (c *Client) DeleteBranch(workspace, repoSlug, name string) error {
+	path := fmt.Sprintf("/repositories/%s/%s/refs/branches/%s", workspace, repoSlug, url.PathEscape(name))
+	return c.doRequest("DELETE", path, nil, nil)
+}
+
+// DeleteTag deletes a tag.
+func (c *Client) DeleteTag(workspace, repoSlug, name string) error {
+	path := fmt.Sprintf("/repositories/%s/%s/refs/tags/%s", workspace, repoSlug, url.PathEscape(name))
+	return c.doRequest("DELETE", path, nil, nil)
+}
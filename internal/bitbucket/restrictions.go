@@ -0,0 +1,214 @@
+package bitbucket
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// BranchRestrictionKinds lists the kinds of branch restriction (branch
+// permissions and merge checks) accepted by the API.
+var BranchRestrictionKinds = []string{
+	"push",
+	"force",
+	"delete",
+	"restrict_merges",
+	"require_approvals_to_merge",
+	"require_default_reviewer_approvals_to_merge",
+	"require_passing_builds_to_merge",
+	"require_tasks_to_be_completed",
+	"require_no_changes_requested",
+	"require_all_dependencies_merged",
+	"require_commits_behind",
+	"enforce_merge_checks",
+	"reset_pullrequest_approvals_on_change",
+	"smart_reset_pullrequest_approvals",
+	"reset_pullrequest_changes_requested_on_change",
+	"allow_auto_merge_when_builds_pass",
+}
+
+// BranchTypes lists the branching model branch types a restriction can
+// apply to instead of a pattern.
+var BranchTypes = []string{"feature", "bugfix", "release", "hotfix", "development", "production"}
+
+// valueKinds take a numeric Value (e.g. the number of approvals).
+var valueKinds = []string{
+	"require_approvals_to_merge",
+	"require_default_reviewer_approvals_to_merge",
+	"require_passing_builds_to_merge",
+	"require_commits_behind",
+}
+
+// exemptionKinds take the users and groups who are exempt from the
+// restriction.
+var exemptionKinds = []string{"push", "restrict_merges"}
+
+// NewBranchRestrictionRequest builds and validates a restriction of kind
+// on the branches matching pattern (a glob) or of branchType (exactly one
+// must be given). value is required by kinds that count something, and
+// users (UUIDs) and groups (slugs) may only be given for kinds that
+// exempt them.
+func NewBranchRestrictionRequest(kind, pattern, branchType string, value *int, users, groups []string) (BranchRestrictionRequest, error) {
+	req := BranchRestrictionRequest{
+		Kind:       kind,
+		Pattern:    pattern,
+		BranchType: branchType,
+		Value:      value,
+		Users:      []PRReviewerRef{},
+		Groups:     []GroupRef{},
+	}
+	if !slices.Contains(BranchRestrictionKinds, kind) {
+		return req, fmt.Errorf("invalid kind %q; must be one of: %s", kind, strings.Join(BranchRestrictionKinds, ", "))
+	}
+	switch {
+	case pattern != "" && branchType != "":
+		return req, fmt.Errorf("%s: give either a pattern or a branch type, not both", kind)
+	case pattern != "":
+		req.BranchMatchKind = "glob"
+	case branchType != "":
+		if !slices.Contains(BranchTypes, branchType) {
+			return req, fmt.Errorf("invalid branch type %q; must be one of: %s", branchType, strings.Join(BranchTypes, ", "))
+		}
+		req.BranchMatchKind = "branching_model"
+	default:
+		return req, fmt.Errorf("%s: a pattern or branch type is required", kind)
+	}
+	if slices.Contains(valueKinds, kind) {
+		if value == nil {
+			return req, fmt.Errorf("%s requires a value", kind)
+		}
+	} else if value != nil {
+		return req, fmt.Errorf("%s does not take a value", kind)
+	}
+	if (len(users) > 0 || len(groups) > 0) && !slices.Contains(exemptionKinds, kind) {
+		return req, fmt.Errorf("%s does not take users or groups; only %s do", kind, strings.Join(exemptionKinds, " and "))
+	}
+	for _, u := range users {
+		req.Users = append(req.Users, PRReviewerRef{UUID: u})
+	}
+	for _, g := range groups {
+		req.Groups = append(req.Groups, GroupRef{Slug: g})
+	}
+	return req, nil
+}
+
+// Match describes which branches a restriction applies to: its pattern,
+// or "type:<branch type>".
+func (r BranchRestriction) Match() string {
+	if r.BranchMatchKind == "branching_model" {
+		return "type:" + r.BranchType
+	}
+	return r.Pattern
+}
+
+// Match is BranchRestriction.Match for a request.
+func (r BranchRestrictionRequest) Match() string {
+	if r.BranchMatchKind == "branching_model" {
+		return "type:" + r.BranchType
+	}
+	return r.Pattern
+}
+
+// BranchRestrictionChange is one step of a plan made by
+// PlanBranchRestrictions. Action is "create", "update" or "delete";
+// Current is the existing restriction for updates and deletions.
+type BranchRestrictionChange struct {
+	Action  string
+	Current *BranchRestriction
+	Request BranchRestrictionRequest
+}
+
+// PlanBranchRestrictions works out the changes that turn existing into
+// desired. Restrictions are matched by kind and branch match; a matched
+// restriction whose value, users or groups differ is updated, and
+// existing restrictions that are not desired are deleted.
+func PlanBranchRestrictions(existing []BranchRestriction, desired []BranchRestrictionRequest) []BranchRestrictionChange {
+	byKey := make(map[string][]*BranchRestriction)
+	for i := range existing {
+		r := &existing[i]
+		key := r.Kind + "\x00" + r.Match()
+		byKey[key] = append(byKey[key], r)
+	}
+
+	var changes []BranchRestrictionChange
+	kept := make(map[int]bool)
+	for _, want := range desired {
+		key := want.Kind + "\x00" + want.Match()
+		candidates := byKey[key]
+		if len(candidates) == 0 {
+			changes = append(changes, BranchRestrictionChange{Action: "create", Request: want})
+			continue
+		}
+		current := candidates[0]
+		byKey[key] = candidates[1:]
+		kept[current.ID] = true
+		if !sameRestriction(*current, want) {
+			changes = append(changes, BranchRestrictionChange{Action: "update", Current: current, Request: want})
+		}
+	}
+	for i := range existing {
+		if !kept[existing[i].ID] {
+			changes = append(changes, BranchRestrictionChange{Action: "delete", Current: &existing[i]})
+		}
+	}
+	return changes
+}
+
+func sameRestriction(r BranchRestriction, want BranchRestrictionRequest) bool {
+	if (r.Value == nil) != (want.Value == nil) || r.Value != nil && *r.Value != *want.Value {
+		return false
+	}
+	var haveUsers, wantUsers, haveGroups, wantGroups []string
+	for _, u := range r.Users {
+		haveUsers = append(haveUsers, u.UUID)
+	}
+	for _, u := range want.Users {
+		wantUsers = append(wantUsers, u.UUID)
+	}
+	for _, g := range r.Groups {
+		haveGroups = append(haveGroups, g.Slug)
+	}
+	for _, g := range want.Groups {
+		wantGroups = append(wantGroups, g.Slug)
+	}
+	return sameSet(haveUsers, wantUsers) && sameSet(haveGroups, wantGroups)
+}
+
+func sameSet(a, b []string) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(slices.Compact(a), slices.Compact(b))
+}
+
+// ListBranchRestrictions lists the branch restrictions of a repository.
+func (c *Client) ListBranchRestrictions(workspace, repoSlug string) ([]BranchRestriction, error) {
+	path := fmt.Sprintf("/repositories/%s/%s/branch-restrictions?pagelen=100", workspace, repoSlug)
+	return listAll[BranchRestriction](c, baseURL+path)
+}
+
+// CreateBranchRestriction creates a branch restriction.
+func (c *Client) CreateBranchRestriction(workspace, repoSlug string, req BranchRestrictionRequest) (*BranchRestriction, error) {
+	path := fmt.Sprintf("/repositories/%s/%s/branch-restrictions", workspace, repoSlug)
+	var resp BranchRestriction
+	if err := c.doRequest("POST", path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// UpdateBranchRestriction replaces a branch restriction.
+func (c *Client) UpdateBranchRestriction(workspace, repoSlug string, id int, req BranchRestrictionRequest) (*BranchRestriction, error) {
+	path := fmt.Sprintf("/repositories/%s/%s/branch-restrictions/%d", workspace, repoSlug, id)
+	var resp BranchRestriction
+	if err := c.doRequest("PUT", path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DeleteBranchRestriction deletes a branch restriction.
+func (c *Client) DeleteBranchRestriction(workspace, repoSlug string, id int) error {
+	path := fmt.Sprintf("/repositories/%s/%s/branch-restrictions/%d", workspace, repoSlug, id)
+	return c.doRequest("DELETE", path, nil, nil)
+}
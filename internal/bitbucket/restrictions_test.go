@@ -0,0 +1,71 @@
+package bitbucket
+
+import "testing"
+
+func TestNewBranchRestrictionRequest_Validation(t *testing.T) {
+	two := 2
+	tests := []struct {
+		name       string
+		kind       string
+		pattern    string
+		branchType string
+		value      *int
+		users      []string
+		wantErr    bool
+	}{
+		{"push with users", "push", "main", "", nil, []string{"{u}"}, false},
+		{"approvals with value", "require_approvals_to_merge", "main", "", &two, nil, false},
+		{"branch type", "delete", "", "release", nil, nil, false},
+		{"unknown kind", "merge", "main", "", nil, nil, true},
+		{"no match", "force", "", "", nil, nil, true},
+		{"both matches", "force", "main", "release", nil, nil, true},
+		{"bad branch type", "force", "", "main", nil, nil, true},
+		{"missing value", "require_passing_builds_to_merge", "main", "", nil, nil, true},
+		{"unexpected value", "force", "main", "", &two, nil, true},
+		{"users on force", "force", "main", "", nil, []string{"{u}"}, true},
+	}
+	for _, tt := range tests {
+		_, err := NewBranchRestrictionRequest(tt.kind, tt.pattern, tt.branchType, tt.value, tt.users, nil)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: err = %v, wantErr %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestPlanBranchRestrictions(t *testing.T) {
+	one, two := 1, 2
+	existing := []BranchRestriction{
+		{ID: 1, Kind: "push", BranchMatchKind: "glob", Pattern: "main", Users: []PRUser{{UUID: "{a}"}}},
+		{ID: 2, Kind: "require_approvals_to_merge", BranchMatchKind: "glob", Pattern: "main", Value: &one},
+		{ID: 3, Kind: "force", BranchMatchKind: "glob", Pattern: "main"},
+	}
+	mustReq := func(kind, pattern string, value *int, users []string) BranchRestrictionRequest {
+		r, err := NewBranchRestrictionRequest(kind, pattern, "", value, users, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r
+	}
+	desired := []BranchRestrictionRequest{
+		mustReq("push", "main", nil, []string{"{a}"}),
+		mustReq("require_approvals_to_merge", "main", &two, nil),
+		mustReq("delete", "main", nil, nil),
+	}
+
+	changes := PlanBranchRestrictions(existing, desired)
+	got := make(map[string]string)
+	for _, c := range changes {
+		got[c.Action] += c.Request.Kind
+		if c.Current != nil {
+			got[c.Action] += c.Current.Kind
+		}
+	}
+	want := map[string]string{
+		"update": "require_approvals_to_mergerequire_approvals_to_merge",
+		"create": "delete",
+		"delete": "force",
+	}
+	if len(changes) != 3 || got["update"] != want["update"] || got["create"] != want["create"] || got["delete"] != want["delete"] {
+		t.Errorf("unexpected plan: %+v", got)
+	}
+}
@@ -0,0 +1,106 @@
+package bitbucket
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// fileHistoryFields asks the file history endpoint to include the commit
+// details it otherwise leaves out.
+const fileHistoryFields = "next,values.path,values.type,values.commit.hash,values.commit.date," +
+	"values.commit.message,values.commit.author.raw,values.commit.author.user.display_name"
+
+// ListSrc lists the entries of the directory at path ("" for the root) as
+// of commit, following pagination up to max entries (0 for all).
+func (c *Client) ListSrc(workspace, repoSlug, commit, path string, max int) ([]SrcEntry, error) {
+	next := fmt.Sprintf("%s/repositories/%s/%s/src/%s/%s?pagelen=100",
+		baseURL, workspace, repoSlug, url.PathEscape(commit), srcPath(path, true))
+
+	var all []SrcEntry
+	for next != "" {
+		resp, body, err := c.send("GET", next, nil)
+		if err != nil {
+			return nil, err
+		}
+		// The same endpoint returns a file's raw content, so anything other
+		// than a JSON listing means path is a file.
+		if !strings.HasPrefix(resp.Header.Get("Content-Type"), "application/json") {
+			return nil, fmt.Errorf("%s is a file, not a directory", path)
+		}
+		var p page[SrcEntry]
+		if err := json.Unmarshal(body, &p); err != nil {
+			return nil, fmt.Errorf("unmarshaling response: %w", err)
+		}
+		for _, e := range p.Values {
+			all = append(all, e)
+			if max > 0 && len(all) >= max {
+				return all, nil
+			}
+		}
+		next = p.Next
+	}
+	return all, nil
+}
+
+// DownloadSrc streams the raw content of the file at path as of commit
+// to w.
+func (c *Client) DownloadSrc(workspace, repoSlug, commit, path string, w io.Writer) error {
+	u := fmt.Sprintf("%s/repositories/%s/%s/src/%s/%s", baseURL, workspace, repoSlug, url.PathEscape(commit), srcPath(path, false))
+	resp, err := c.open("GET", u, nil, http.Header{"Accept": {"*/*"}})
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if _, err := io.Copy(w, resp.Body); err != nil {
+		return fmt.Errorf("reading %s: %w", path, err)
+	}
+	return nil
+}
+
+// ListFileHistory lists the revisions of the file at path reachable from
+// commit, newest first and following renames, up to max (0 for all).
+func (c *Client) ListFileHistory(workspace, repoSlug, commit, path string, max int) ([]SrcEntry, error) {
+	params := url.Values{}
+	params.Set("fields", fileHistoryFields)
+	params.Set("pagelen", "50")
+	next := fmt.Sprintf("%s/repositories/%s/%s/filehistory/%s/%s?%s",
+		baseURL, workspace, repoSlug, url.PathEscape(commit), srcPath(path, false), params.Encode())
+
+	var all []SrcEntry
+	for next != "" {
+		var p page[SrcEntry]
+		if err := c.doRequestURL("GET", next, nil, &p); err != nil {
+			return nil, err
+		}
+		for _, e := range p.Values {
+			all = append(all, e)
+			if max > 0 && len(all) >= max {
+				return all, nil
+			}
+		}
+		next = p.Next
+	}
+	return all, nil
+}
+
+// srcPath escapes each segment of a repository path. Directory listings
+// need a trailing slash.
+func srcPath(path string, dir bool) string {
+	path = strings.Trim(path, "/")
+	if path == "" {
+		return ""
+	}
+	segments := strings.Split(path, "/")
+	for i, s := range segments {
+		segments[i] = url.PathEscape(s)
+	}
+	escaped := strings.Join(segments, "/")
+	if dir {
+		escaped += "/"
+	}
+	return escaped
+}
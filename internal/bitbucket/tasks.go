@@ -0,0 +1,35 @@
+package bitbucket
+
+import "fmt"
+
+// ListPRTasks lists all tasks on a pull request.
+func (c *Client) ListPRTasks(workspace, repoSlug string, prID int) ([]PRTask, error) {
+	path := fmt.Sprintf("/repositories/%s/%s/pullrequests/%d/tasks?pagelen=100", workspace, repoSlug, prID)
+	return listAll[PRTask](c, baseURL+path)
+}
+
+// CreatePRTask creates a task on a pull request.
+func (c *Client) CreatePRTask(workspace, repoSlug string, prID int, req CreatePRTaskRequest) (*PRTask, error) {
+	path := fmt.Sprintf("/repositories/%s/%s/pullrequests/%d/tasks", workspace, repoSlug, prID)
+	var resp PRTask
+	if err := c.doRequest("POST", path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// UpdatePRTask updates a task's content or state.
+func (c *Client) UpdatePRTask(workspace, repoSlug string, prID, taskID int, req UpdatePRTaskRequest) (*PRTask, error) {
+	path := fmt.Sprintf("/repositories/%s/%s/pullrequests/%d/tasks/%d", workspace, repoSlug, prID, taskID)
+	var resp PRTask
+	if err := c.doRequest("PUT", path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DeletePRTask deletes a task from a pull request.
+func (c *Client) DeletePRTask(workspace, repoSlug string, prID, taskID int) error {
+	path := fmt.Sprintf("/repositories/%s/%s/pullrequests/%d/tasks/%d", workspace, repoSlug, prID, taskID)
+	return c.doRequest("DELETE", path, nil, nil)
+}
@@ -1,16 +1,23 @@
 package bitbucket
 
+import "fmt"
+
 // Repository represents a Bitbucket repository.
 type Repository struct {
-	Slug        string     `json:"slug"`
-	Name        string     `json:"name"`
-	FullName    string     `json:"full_name"`
-	Description string     `json:"description"`
-	IsPrivate   bool       `json:"is_private"`
-	Language    string     `json:"language"`
-	UpdatedOn   string     `json:"updated_on"`
-	MainBranch  *Branch    `json:"mainbranch"`
-	Links       RepoLinks  `json:"links"`
+	Slug        string       `json:"slug"`
+	Name        string       `json:"name"`
+	FullName    string       `json:"full_name"`
+	Description string       `json:"description"`
+	IsPrivate   bool         `json:"is_private"`
+	Language    string       `json:"language"`
+	UpdatedOn   string       `json:"updated_on"`
+	MainBranch  *Branch      `json:"mainbranch"`
+	Links       RepoLinks    `json:"links"`
+	Project     *RepoProject `json:"project"`
+	HasIssues   bool         `json:"has_issues"`
+	HasWiki     bool         `json:"has_wiki"`
+	ForkPolicy  string       `json:"fork_policy"`
+	Parent      *PRRepo      `json:"parent"`
 }
 
 type Branch struct {
@@ -26,39 +33,84 @@ type Link struct {
 	Href string `json:"href"`
 }
 
-// RepositoriesResponse is the paginated response for listing repositories.
-type RepositoriesResponse struct {
-	PageLen int          `json:"pagelen"`
-	Size    int          `json:"size"`
-	Page    int          `json:"page"`
-	Values  []Repository `json:"values"`
+// RepoProject is the project a repository belongs to. Only Key is needed
+// when referring to a project in a request.
+type RepoProject struct {
+	Key  string `json:"key"`
+	Name string `json:"name,omitempty"`
 }
 
-// PullRequestsResponse is the paginated response for listing pull requests.
-type PullRequestsResponse struct {
-	PageLen int           `json:"pagelen"`
-	Size    int           `json:"size"`
-	Page    int           `json:"page"`
-	Values  []PullRequest `json:"values"`
+// CreateRepositoryRequest is the request body for creating a repository.
+// IsPrivate is left to the workspace default when nil.
+type CreateRepositoryRequest struct {
+	SCM         string       `json:"scm"`
+	IsPrivate   *bool        `json:"is_private,omitempty"`
+	Project     *RepoProject `json:"project,omitempty"`
+	Language    string       `json:"language,omitempty"`
+	Description string       `json:"description,omitempty"`
+}
+
+// ForkRepositoryRequest is the request body for forking a repository.
+type ForkRepositoryRequest struct {
+	Name      string         `json:"name,omitempty"`
+	Workspace *WorkspaceSlug `json:"workspace,omitempty"`
+	IsPrivate *bool          `json:"is_private,omitempty"`
+	Project   *RepoProject   `json:"project,omitempty"`
+}
+
+type WorkspaceSlug struct {
+	Slug string `json:"slug"`
+}
+
+// UpdateRepositoryRequest is the request body for updating repository
+// settings; nil fields are left unchanged.
+type UpdateRepositoryRequest struct {
+	Description *string `json:"description,omitempty"`
+	MainBranch  *Branch `json:"mainbranch,omitempty"`
+	HasIssues   *bool   `json:"has_issues,omitempty"`
+	IsPrivate   *bool   `json:"is_private,omitempty"`
+	Language    *string `json:"language,omitempty"`
 }
 
 // PullRequest represents a Bitbucket pull request.
 type PullRequest struct {
-	ID          int       `json:"id"`
-	Title       string    `json:"title"`
-	State       string    `json:"state"`
-	Description string    `json:"description"`
-	Source      PRRef     `json:"source"`
-	Destination PRRef     `json:"destination"`
-	Author      PRUser    `json:"author"`
-	CreatedOn   string    `json:"created_on"`
-	UpdatedOn   string    `json:"updated_on"`
-	Links       PRLinks   `json:"links"`
+	ID                int             `json:"id"`
+	Title             string          `json:"title"`
+	State             string          `json:"state"`
+	Description       string          `json:"description"`
+	Source            PRRef           `json:"source"`
+	Destination       PRRef           `json:"destination"`
+	Author            PRUser          `json:"author"`
+	CreatedOn         string          `json:"created_on"`
+	UpdatedOn         string          `json:"updated_on"`
+	Links             PRLinks         `json:"links"`
+	Reviewers         []PRUser        `json:"reviewers"`
+	Participants      []PRParticipant `json:"participants"`
+	MergeCommit       *PRCommit       `json:"merge_commit"`
+	CloseSourceBranch bool            `json:"close_source_branch"`
+	CommentCount      int             `json:"comment_count"`
+	TaskCount         int             `json:"task_count"`
+	Draft             bool            `json:"draft"`
 }
 
 type PRRef struct {
-	Branch   Branch     `json:"branch"`
-	Repository *PRRepo `json:"repository"`
+	Branch     Branch    `json:"branch"`
+	Commit     *PRCommit `json:"commit"`
+	Repository *PRRepo   `json:"repository"`
+}
+
+type PRCommit struct {
+	Hash string `json:"hash"`
+}
+
+// PRParticipant is a user's involvement in a pull request. Role is
+// "REVIEWER" or "PARTICIPANT"; State is "approved", "changes_requested" or
+// empty.
+type PRParticipant struct {
+	User     PRUser `json:"user"`
+	Role     string `json:"role"`
+	Approved bool   `json:"approved"`
+	State    string `json:"state"`
 }
 
 type PRRepo struct {
@@ -68,6 +120,8 @@ type PRRepo struct {
 type PRUser struct {
 	DisplayName string `json:"display_name"`
 	Nickname    string `json:"nickname"`
+	AccountID   string `json:"account_id,omitempty"`
+	UUID        string `json:"uuid,omitempty"`
 }
 
 type PRLinks struct {
@@ -76,10 +130,112 @@ type PRLinks struct {
 
 // CreatePRRequest is the request body for creating a pull request.
 type CreatePRRequest struct {
-	Title       string       `json:"title"`
-	Source      CreatePRRef  `json:"source"`
-	Destination *CreatePRRef `json:"destination,omitempty"`
-	Description string       `json:"description,omitempty"`
+	Title             string          `json:"title"`
+	Source            CreatePRRef     `json:"source"`
+	Destination       *CreatePRRef    `json:"destination,omitempty"`
+	Description       string          `json:"description,omitempty"`
+	Reviewers         []PRReviewerRef `json:"reviewers,omitempty"`
+	CloseSourceBranch bool            `json:"close_source_branch,omitempty"`
+	Draft             bool            `json:"draft,omitempty"`
+}
+
+// UpdatePRRequest is the request body for updating a pull request. It
+// carries the full set of editable fields, so callers start from the
+// current pull request and change what they need.
+type UpdatePRRequest struct {
+	Title             string          `json:"title"`
+	Description       string          `json:"description"`
+	Destination       *CreatePRRef    `json:"destination,omitempty"`
+	Reviewers         []PRReviewerRef `json:"reviewers"`
+	CloseSourceBranch bool            `json:"close_source_branch"`
+	Draft             bool            `json:"draft"`
+}
+
+// PRReviewerRef identifies a reviewer by UUID.
+type PRReviewerRef struct {
+	UUID string `json:"uuid"`
+}
+
+// DefaultReviewer is an entry of a repository's effective default
+// reviewers. ReviewerType is "repository" or "project", depending on
+// where it was configured.
+type DefaultReviewer struct {
+	User         PRUser `json:"user"`
+	ReviewerType string `json:"reviewer_type"`
+}
+
+// Workspace is a Bitbucket workspace.
+type Workspace struct {
+	UUID      string    `json:"uuid"`
+	Slug      string    `json:"slug"`
+	Name      string    `json:"name"`
+	IsPrivate bool      `json:"is_private"`
+	CreatedOn string    `json:"created_on"`
+	Links     RepoLinks `json:"links"`
+}
+
+// WorkspaceAccess is a workspace the current user belongs to.
+type WorkspaceAccess struct {
+	Administrator bool      `json:"administrator"`
+	Workspace     Workspace `json:"workspace"`
+}
+
+// Project is a project within a workspace.
+type Project struct {
+	UUID        string    `json:"uuid"`
+	Key         string    `json:"key"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	IsPrivate   bool      `json:"is_private"`
+	CreatedOn   string    `json:"created_on"`
+	UpdatedOn   string    `json:"updated_on"`
+	Links       RepoLinks `json:"links"`
+}
+
+// CreateProjectRequest is the request body for creating a project.
+// IsPrivate is left to the API default (private) when nil.
+type CreateProjectRequest struct {
+	Key         string `json:"key"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	IsPrivate   *bool  `json:"is_private,omitempty"`
+}
+
+// Webhook is a repository or workspace webhook.
+type Webhook struct {
+	UUID                 string   `json:"uuid"`
+	URL                  string   `json:"url"`
+	Description          string   `json:"description"`
+	SubjectType          string   `json:"subject_type"`
+	Active               bool     `json:"active"`
+	Events               []string `json:"events"`
+	SecretSet            bool     `json:"secret_set"`
+	SkipCertVerification bool     `json:"skip_cert_verification"`
+	CreatedAt            string   `json:"created_at"`
+}
+
+// HookEvent is an event type a webhook can subscribe to.
+type HookEvent struct {
+	Event       string `json:"event"`
+	Category    string `json:"category"`
+	Label       string `json:"label"`
+	Description string `json:"description"`
+}
+
+// WebhookRequest is the request body for creating or updating a webhook.
+// Secret is left unchanged on update when nil.
+type WebhookRequest struct {
+	URL                  string   `json:"url"`
+	Description          string   `json:"description"`
+	Active               bool     `json:"active"`
+	Events               []string `json:"events"`
+	Secret               *string  `json:"secret,omitempty"`
+	SkipCertVerification bool     `json:"skip_cert_verification"`
+}
+
+// WorkspaceMembership is a user's membership of a workspace.
+type WorkspaceMembership struct {
+	User PRUser `json:"user"`
 }
 
 type CreatePRRef struct {
@@ -90,6 +246,24 @@ type CreatePRBranch struct {
 	Name string `json:"name"`
 }
 
+// MergePRRequest is the request body for merging a pull request.
+type MergePRRequest struct {
+	Type              string `json:"type"`
+	Message           string `json:"message,omitempty"`
+	CloseSourceBranch *bool  `json:"close_source_branch,omitempty"`
+	MergeStrategy     string `json:"merge_strategy,omitempty"`
+}
+
+// MergeTaskStatus is the status of an asynchronous merge. TaskStatus is
+// "PENDING" until the merge finishes, then "SUCCESS" with MergeResult set.
+type MergeTaskStatus struct {
+	TaskStatus  string       `json:"task_status"`
+	MergeResult *PullRequest `json:"merge_result"`
+}
+
+// MergeStrategies lists the merge strategies Bitbucket Cloud accepts.
+var MergeStrategies = []string{"merge_commit", "squash", "fast_forward", "squash_fast_forward", "rebase_fast_forward", "rebase_merge"}
+
 // PRCommentParent is the parent comment reference for replies.
 type PRCommentParent struct {
 	ID int `json:"id"`
@@ -147,6 +321,364 @@ type PRCommentsResponse struct {
 	Next    string      `json:"next"`
 }
 
+// PRTask is a task (checklist item) on a pull request. State is
+// "RESOLVED" or "UNRESOLVED"; Comment is set when the task is anchored to
+// a comment.
+type PRTask struct {
+	ID         int              `json:"id"`
+	Content    PRCommentContent `json:"content"`
+	State      string           `json:"state"`
+	Creator    PRUser           `json:"creator"`
+	CreatedOn  string           `json:"created_on"`
+	UpdatedOn  string           `json:"updated_on"`
+	ResolvedOn string           `json:"resolved_on"`
+	ResolvedBy *PRUser          `json:"resolved_by"`
+	Comment    *PRCommentParent `json:"comment"`
+}
+
+// CreatePRTaskRequest is the request body for creating a PR task.
+type CreatePRTaskRequest struct {
+	Content PRCommentContent `json:"content"`
+	Comment *PRCommentParent `json:"comment,omitempty"`
+}
+
+// UpdatePRTaskRequest is the request body for updating a PR task.
+type UpdatePRTaskRequest struct {
+	Content *PRCommentContent `json:"content,omitempty"`
+	State   string            `json:"state,omitempty"`
+}
+
+// PRActivity is an entry of a pull request's activity feed. Exactly one of
+// its fields is set, depending on the kind of activity.
+type PRActivity struct {
+	Update           *PRActivityUpdate   `json:"update"`
+	Approval         *PRActivityApproval `json:"approval"`
+	ChangesRequested *PRActivityApproval `json:"changes_requested"`
+	Comment          *PRComment          `json:"comment"`
+}
+
+// Date returns when the activity happened.
+func (a PRActivity) Date() string {
+	switch {
+	case a.Update != nil:
+		return a.Update.Date
+	case a.Approval != nil:
+		return a.Approval.Date
+	case a.ChangesRequested != nil:
+		return a.ChangesRequested.Date
+	case a.Comment != nil:
+		return a.Comment.CreatedOn
+	}
+	return ""
+}
+
+// PRActivityUpdate records a change to the pull request: new commits
+// pushed to the source branch, or edits to its title, description, state
+// or reviewers.
+type PRActivityUpdate struct {
+	Date        string   `json:"date"`
+	Author      PRUser   `json:"author"`
+	State       string   `json:"state"`
+	Title       string   `json:"title"`
+	Description string   `json:"description"`
+	Source      PRRef    `json:"source"`
+	Destination PRRef    `json:"destination"`
+	Reviewers   []PRUser `json:"reviewers"`
+}
+
+// PRActivityApproval records an approval or change request.
+type PRActivityApproval struct {
+	Date string `json:"date"`
+	User PRUser `json:"user"`
+}
+
+// CommitStatus is a build or other status reported against a commit.
+// State is one of SUCCESSFUL, FAILED, INPROGRESS or STOPPED.
+type CommitStatus struct {
+	Key         string `json:"key"`
+	Name        string `json:"name"`
+	State       string `json:"state"`
+	URL         string `json:"url"`
+	Description string `json:"description"`
+	CreatedOn   string `json:"created_on"`
+	UpdatedOn   string `json:"updated_on"`
+}
+
+// DiffStat is the change summary for one file. Status is one of "added",
+// "removed", "modified", "renamed", "merge conflict", "local deleted" or
+// "remote deleted"; Old is nil for added files and New for removed ones.
+type DiffStat struct {
+	Status       string        `json:"status"`
+	LinesAdded   int           `json:"lines_added"`
+	LinesRemoved int           `json:"lines_removed"`
+	Old          *DiffStatFile `json:"old"`
+	New          *DiffStatFile `json:"new"`
+}
+
+type DiffStatFile struct {
+	Path string `json:"path"`
+}
+
+// Path returns the file's path after the change, or before it for removed
+// files.
+func (d DiffStat) Path() string {
+	if d.New != nil {
+		return d.New.Path
+	}
+	if d.Old != nil {
+		return d.Old.Path
+	}
+	return ""
+}
+
+// Pipeline is a Bitbucket Pipelines run.
+type Pipeline struct {
+	UUID              string         `json:"uuid"`
+	BuildNumber       int            `json:"build_number"`
+	State             PipelineState  `json:"state"`
+	Target            PipelineTarget `json:"target"`
+	Creator           *PRUser        `json:"creator"`
+	Trigger           PipelineName   `json:"trigger"`
+	CreatedOn         string         `json:"created_on"`
+	CompletedOn       string         `json:"completed_on"`
+	DurationInSeconds int            `json:"duration_in_seconds"`
+}
+
+// PipelineName is a named enum value, as used for pipeline triggers,
+// results and stages.
+type PipelineName struct {
+	Name string `json:"name"`
+}
+
+// PipelineState is the state of a pipeline or step. Name is PENDING,
+// IN_PROGRESS or COMPLETED (steps may also be READY, PAUSED or HALTED);
+// Result is set once completed.
+type PipelineState struct {
+	Name   string        `json:"name"`
+	Result *PipelineName `json:"result,omitempty"`
+	Stage  *PipelineName `json:"stage,omitempty"`
+}
+
+// Status returns the result of a completed pipeline or step (SUCCESSFUL,
+// FAILED, ERROR, STOPPED, ...), and its state otherwise.
+func (s PipelineState) Status() string {
+	if s.Result != nil && s.Result.Name != "" {
+		return s.Result.Name
+	}
+	if s.Stage != nil && s.Stage.Name != "" {
+		return s.Stage.Name
+	}
+	return s.Name
+}
+
+// Done reports whether the pipeline or step has finished.
+func (s PipelineState) Done() bool {
+	return s.Name == "COMPLETED"
+}
+
+// PipelineTarget describes what a pipeline runs on. Type is
+// "pipeline_ref_target" (a branch or tag, optionally at a commit) or
+// "pipeline_commit_target" (a bare commit).
+type PipelineTarget struct {
+	Type     string            `json:"type"`
+	RefType  string            `json:"ref_type,omitempty"`
+	RefName  string            `json:"ref_name,omitempty"`
+	Commit   *PipelineCommit   `json:"commit,omitempty"`
+	Selector *PipelineSelector `json:"selector,omitempty"`
+}
+
+type PipelineCommit struct {
+	Type string `json:"type,omitempty"`
+	Hash string `json:"hash"`
+}
+
+// PipelineSelector selects which pipeline definition of
+// bitbucket-pipelines.yml to run, e.g. {Type: "custom", Pattern: "deploy"}.
+type PipelineSelector struct {
+	Type    string `json:"type"`
+	Pattern string `json:"pattern,omitempty"`
+}
+
+// PipelineVariable is a variable passed to a pipeline run.
+type PipelineVariable struct {
+	Key     string `json:"key"`
+	Value   string `json:"value"`
+	Secured bool   `json:"secured,omitempty"`
+}
+
+// RunPipelineRequest is the request body for triggering a pipeline.
+type RunPipelineRequest struct {
+	Target    PipelineTarget     `json:"target"`
+	Variables []PipelineVariable `json:"variables,omitempty"`
+}
+
+// PipelineStep is a step of a pipeline run.
+type PipelineStep struct {
+	UUID              string        `json:"uuid"`
+	Name              string        `json:"name"`
+	State             PipelineState `json:"state"`
+	StartedOn         string        `json:"started_on"`
+	CompletedOn       string        `json:"completed_on"`
+	DurationInSeconds int           `json:"duration_in_seconds"`
+}
+
+// PipelineVariableEntry is a repository or deployment environment
+// variable. Value is empty for secured variables.
+type PipelineVariableEntry struct {
+	UUID    string `json:"uuid,omitempty"`
+	Key     string `json:"key"`
+	Value   string `json:"value"`
+	Secured bool   `json:"secured"`
+}
+
+// Environment is a deployment environment.
+type Environment struct {
+	UUID            string       `json:"uuid"`
+	Name            string       `json:"name"`
+	Slug            string       `json:"slug"`
+	EnvironmentType PipelineName `json:"environment_type"`
+}
+
+// PipelineSchedule is a scheduled pipeline run. CronPattern is a Quartz
+// cron expression in UTC with seconds first, e.g. "0 0 2 * * ? *".
+type PipelineSchedule struct {
+	UUID        string         `json:"uuid,omitempty"`
+	Type        string         `json:"type,omitempty"`
+	Enabled     bool           `json:"enabled"`
+	CronPattern string         `json:"cron_pattern"`
+	Target      PipelineTarget `json:"target"`
+	CreatedOn   string         `json:"created_on,omitempty"`
+	UpdatedOn   string         `json:"updated_on,omitempty"`
+}
+
+// PipelineCache is a dependency cache saved by pipeline steps.
+type PipelineCache struct {
+	UUID          string `json:"uuid"`
+	Name          string `json:"name"`
+	Path          string `json:"path"`
+	FileSizeBytes int64  `json:"file_size_bytes"`
+	CreatedOn     string `json:"created_on"`
+}
+
+// CommitStatusStates lists the states a commit status can be set to.
+var CommitStatusStates = []string{"SUCCESSFUL", "FAILED", "INPROGRESS", "STOPPED"}
+
+// SetCommitStatusRequest is the request body for reporting a build status
+// on a commit. Statuses are keyed by Key: reporting the same key again
+// replaces the earlier status.
+type SetCommitStatusRequest struct {
+	Key         string `json:"key"`
+	State       string `json:"state"`
+	Name        string `json:"name,omitempty"`
+	URL         string `json:"url"`
+	Description string `json:"description,omitempty"`
+}
+
+// Ref is a branch or tag. Message and Date are only set for annotated
+// tags; Target is the commit the ref points at.
+type Ref struct {
+	Name    string    `json:"name"`
+	Type    string    `json:"type"`
+	Message string    `json:"message"`
+	Date    string    `json:"date"`
+	Target  RefTarget `json:"target"`
+}
+
+type RefTarget struct {
+	Hash    string       `json:"hash"`
+	Date    string       `json:"date"`
+	Message string       `json:"message"`
+	Author  CommitAuthor `json:"author"`
+}
+
+// Commit is a commit as returned by the commit and commits endpoints.
+type Commit struct {
+	Hash    string       `json:"hash"`
+	Date    string       `json:"date"`
+	Message string       `json:"message"`
+	Author  CommitAuthor `json:"author"`
+	Parents []PRCommit   `json:"parents"`
+}
+
+// SrcEntry is a file or directory in a source listing, or a revision of
+// a file in its history. Type is "commit_file" or "commit_directory"; Size
+// is only set for files.
+type SrcEntry struct {
+	Type   string  `json:"type"`
+	Path   string  `json:"path"`
+	Size   int64   `json:"size"`
+	Commit *Commit `json:"commit"`
+}
+
+// CommitAuthor is a commit's author. Raw is the "Name <email>" string from
+// the commit; User is only set when it maps to a Bitbucket account.
+type CommitAuthor struct {
+	Raw  string  `json:"raw"`
+	User *PRUser `json:"user"`
+}
+
+// CreateRefRequest is the request body for creating a branch or tag.
+// Target.Hash may be a commit hash or a branch name.
+type CreateRefRequest struct {
+	Name    string   `json:"name"`
+	Target  PRCommit `json:"target"`
+	Message string   `json:"message,omitempty"`
+}
+
+// BranchRestriction is a branch permission or merge check.
+// BranchMatchKind is "glob" (matching Pattern) or "branching_model"
+// (matching BranchType). Value is set for kinds that count something, such
+// as require_approvals_to_merge; Users and Groups are those exempt from
+// push and restrict_merges restrictions.
+type BranchRestriction struct {
+	ID              int      `json:"id"`
+	Kind            string   `json:"kind"`
+	BranchMatchKind string   `json:"branch_match_kind"`
+	Pattern         string   `json:"pattern"`
+	BranchType      string   `json:"branch_type"`
+	Value           *int     `json:"value"`
+	Users           []PRUser `json:"users"`
+	Groups          []Group  `json:"groups"`
+}
+
+// Group is a workspace user group.
+type Group struct {
+	Slug string `json:"slug"`
+	Name string `json:"name"`
+}
+
+// BranchRestrictionRequest is the request body for creating or replacing
+// a branch restriction; build it with NewBranchRestrictionRequest.
+type BranchRestrictionRequest struct {
+	Kind            string          `json:"kind"`
+	BranchMatchKind string          `json:"branch_match_kind"`
+	Pattern         string          `json:"pattern,omitempty"`
+	BranchType      string          `json:"branch_type,omitempty"`
+	Value           *int            `json:"value,omitempty"`
+	Users           []PRReviewerRef `json:"users"`
+	Groups          []GroupRef      `json:"groups"`
+}
+
+type GroupRef struct {
+	Slug string `json:"slug"`
+}
+
+// RepoPermission is a user's or group's explicit permission ("read",
+// "write" or "admin") on a repository. Exactly one of User and Group is
+// set; Repository is only set in workspace-wide listings.
+type RepoPermission struct {
+	Permission string  `json:"permission"`
+	User       *PRUser `json:"user"`
+	Group      *Group  `json:"group"`
+	Repository *PRRepo `json:"repository"`
+}
+
+// page is a single page of a paginated API response.
+type page[T any] struct {
+	Values []T    `json:"values"`
+	Next   string `json:"next"`
+}
+
 // BBUser represents the authenticated Bitbucket user.
 type BBUser struct {
 	DisplayName string `json:"display_name"`
@@ -168,3 +700,14 @@ type APIErrorDetail struct {
 func (e *APIError) String() string {
 	return e.Error.Message
 }
+
+// StatusError is returned for API responses with a non-2xx status, so
+// callers can react to specific statuses (e.g. 404) with errors.As.
+type StatusError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("bitbucket API error (%d): %s", e.StatusCode, e.Message)
+}
@@ -0,0 +1,64 @@
+package bitbucket
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// ListWorkspaceMembers lists every member of a workspace.
+func (c *Client) ListWorkspaceMembers(workspace string) ([]WorkspaceMembership, error) {
+	path := fmt.Sprintf("/workspaces/%s/members?pagelen=100", workspace)
+	return listAll[WorkspaceMembership](c, baseURL+path)
+}
+
+// GetUser retrieves a user by UUID (with braces) or account id.
+func (c *Client) GetUser(uuidOrAccountID string) (*PRUser, error) {
+	var resp PRUser
+	if err := c.doRequest("GET", "/users/"+url.PathEscape(uuidOrAccountID), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ResolveUser turns a user reference into a user. query may be a UUID
+// ("{...}"), an account id, or a nickname or exact (case-insensitive)
+// display name of a member of workspace. A name matching more than one
+// member is an error listing the candidates rather than a guess.
+func (c *Client) ResolveUser(workspace, query string) (*PRUser, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, fmt.Errorf("empty user reference")
+	}
+	if strings.HasPrefix(query, "{") || strings.Contains(query, ":") {
+		u, err := c.GetUser(query)
+		if err != nil {
+			return nil, fmt.Errorf("resolving user %q: %w", query, err)
+		}
+		return u, nil
+	}
+
+	members, err := c.ListWorkspaceMembers(workspace)
+	if err != nil {
+		return nil, fmt.Errorf("resolving user %q: %w", query, err)
+	}
+	var matches []PRUser
+	for _, m := range members {
+		if strings.EqualFold(m.User.Nickname, query) || strings.EqualFold(m.User.DisplayName, query) ||
+			m.User.AccountID == query {
+			matches = append(matches, m.User)
+		}
+	}
+	switch len(matches) {
+	case 1:
+		return &matches[0], nil
+	case 0:
+		return nil, fmt.Errorf("no member of workspace %s matching %q", workspace, query)
+	}
+	parts := make([]string, len(matches))
+	for i, u := range matches {
+		parts[i] = fmt.Sprintf("%s (%s, %s)", u.DisplayName, u.Nickname, u.UUID)
+	}
+	return nil, fmt.Errorf("%q matches %d users; use a nickname, account ID or UUID instead: %s",
+		query, len(matches), strings.Join(parts, ", "))
+}
@@ -0,0 +1,59 @@
+package bitbucket
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// hooksPath is the webhook collection of a repository, or of the
+// workspace itself when repoSlug is empty.
+func hooksPath(workspace, repoSlug string) string {
+	if repoSlug == "" {
+		return fmt.Sprintf("/workspaces/%s/hooks", workspace)
+	}
+	return fmt.Sprintf("/repositories/%s/%s/hooks", workspace, repoSlug)
+}
+
+// ListWebhooks lists the webhooks of a repository, or of the workspace
+// when repoSlug is empty.
+func (c *Client) ListWebhooks(workspace, repoSlug string) ([]Webhook, error) {
+	return listAll[Webhook](c, baseURL+hooksPath(workspace, repoSlug)+"?pagelen=100")
+}
+
+// GetWebhook retrieves a webhook by UUID.
+func (c *Client) GetWebhook(workspace, repoSlug, uuid string) (*Webhook, error) {
+	var resp Webhook
+	if err := c.doRequest("GET", hooksPath(workspace, repoSlug)+"/"+url.PathEscape(uuid), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// CreateWebhook creates a webhook.
+func (c *Client) CreateWebhook(workspace, repoSlug string, req WebhookRequest) (*Webhook, error) {
+	var resp Webhook
+	if err := c.doRequest("POST", hooksPath(workspace, repoSlug), req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// UpdateWebhook replaces the settings of a webhook.
+func (c *Client) UpdateWebhook(workspace, repoSlug, uuid string, req WebhookRequest) (*Webhook, error) {
+	var resp Webhook
+	if err := c.doRequest("PUT", hooksPath(workspace, repoSlug)+"/"+url.PathEscape(uuid), req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DeleteWebhook deletes a webhook.
+func (c *Client) DeleteWebhook(workspace, repoSlug, uuid string) error {
+	return c.doRequest("DELETE", hooksPath(workspace, repoSlug)+"/"+url.PathEscape(uuid), nil, nil)
+}
+
+// ListHookEvents lists the event types a webhook on subjectType
+// ("repository" or "workspace") can subscribe to.
+func (c *Client) ListHookEvents(subjectType string) ([]HookEvent, error) {
+	return listAll[HookEvent](c, baseURL+"/hook_events/"+url.PathEscape(subjectType)+"?pagelen=100")
+}
@@ -0,0 +1,67 @@
+package bitbucket
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// ListWorkspaces lists the workspaces the current user is a member of.
+func (c *Client) ListWorkspaces() ([]WorkspaceAccess, error) {
+	return listAll[WorkspaceAccess](c, baseURL+"/user/workspaces?pagelen=100")
+}
+
+// GetWorkspace retrieves a workspace.
+func (c *Client) GetWorkspace(workspace string) (*Workspace, error) {
+	var resp Workspace
+	if err := c.doRequest("GET", "/workspaces/"+url.PathEscape(workspace), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListProjects lists the projects of a workspace whose key or name
+// contains query (all of them if query is empty), following pagination
+// until max results (0 for all of them).
+func (c *Client) ListProjects(workspace, query string, max int) ([]Project, error) {
+	params := url.Values{}
+	if query != "" {
+		params.Set("q", fmt.Sprintf("key ~ %s OR name ~ %s", bbqlQuote(query), bbqlQuote(query)))
+	}
+	params.Set("sort", "key")
+	params.Set("pagelen", "100")
+	next := fmt.Sprintf("%s/workspaces/%s/projects?%s", baseURL, workspace, params.Encode())
+
+	var all []Project
+	for next != "" {
+		var p page[Project]
+		if err := c.doRequestURL("GET", next, nil, &p); err != nil {
+			return nil, err
+		}
+		all = append(all, p.Values...)
+		if max > 0 && len(all) >= max {
+			return all[:max], nil
+		}
+		next = p.Next
+	}
+	return all, nil
+}
+
+// GetProject retrieves a project by key.
+func (c *Client) GetProject(workspace, key string) (*Project, error) {
+	path := fmt.Sprintf("/workspaces/%s/projects/%s", workspace, url.PathEscape(key))
+	var resp Project
+	if err := c.doRequest("GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// CreateProject creates a project.
+func (c *Client) CreateProject(workspace string, req CreateProjectRequest) (*Project, error) {
+	path := fmt.Sprintf("/workspaces/%s/projects", workspace)
+	var resp Project
+	if err := c.doRequest("POST", path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -26,6 +26,11 @@ type Client struct {
 	// requires a lookup against /rest/api/3/field.
 	storyPointsFieldID    string
 	storyPointsFieldKnown bool
+
+	// userCache holds users resolved by ResolveUser, keyed by lowercased
+	// query; it is persisted to userCachePath when one is set.
+	userCache     map[string]cachedUser
+	userCachePath string
 }
 
 // NewClient creates a new Jira client from credentials.
@@ -52,13 +57,12 @@ func (c *Client) BaseURL() string {
 	return c.baseURL
 }
 
-// CreateIssue creates a new issue. Pass parentKey to set the parent issue
-// (an epic for standard issues, or the parent task for sub-tasks). issueType
-// may be either an issue type name (e.g. "Task", "タスク") or a numeric id;
-// names are resolved against the project's createmeta so that the correct
-// project-scoped id is sent — sending only the name can fail with
-// "Invalid issue type" when the same name exists in multiple schemes.
-func (c *Client) CreateIssue(project, issueType, summary, description, dueDate, parentKey string) (*CreateIssueResponse, error) {
+// CreateIssue creates a new issue. issueType may be either an issue type
+// name (e.g. "Task", "タスク") or a numeric id; names are resolved against
+// the project's createmeta so that the correct project-scoped id is sent —
+// sending only the name can fail with "Invalid issue type" when the same
+// name exists in multiple schemes.
+func (c *Client) CreateIssue(project, issueType, summary string, opts CreateIssueOptions) (*CreateIssueResponse, error) {
 	it, err := c.resolveIssueType(project, issueType)
 	if err != nil {
 		return nil, err
@@ -68,21 +72,31 @@ func (c *Client) CreateIssue(project, issueType, summary, description, dueDate,
 			Project:   ProjectKey{Key: project},
 			Summary:   summary,
 			IssueType: it,
+			DueDate:   opts.DueDate,
+			Labels:    opts.Labels,
 		},
 	}
-	if description != "" {
-		desc := adf.TextToADF(description)
+	if opts.Description != "" {
+		desc, err := c.textToADF(opts.Description)
+		if err != nil {
+			return nil, err
+		}
 		req.Fields.Description = &desc
 	}
-	if dueDate != "" {
-		req.Fields.DueDate = dueDate
+	if opts.ParentKey != "" {
+		req.Fields.Parent = &ParentRef{Key: opts.ParentKey}
 	}
-	if parentKey != "" {
-		req.Fields.Parent = &ParentRef{Key: parentKey}
+	if opts.AssigneeID != "" {
+		req.Fields.Assignee = &AccountRef{AccountID: opts.AssigneeID}
+	}
+
+	body, err := c.withCustomFields(req, opts.CustomFields)
+	if err != nil {
+		return nil, err
 	}
 
 	var resp CreateIssueResponse
-	if err := c.doRequest("POST", "/rest/api/3/issue", req, &resp); err != nil {
+	if err := c.doRequest("POST", "/rest/api/3/issue", body, &resp); err != nil {
 		return nil, err
 	}
 	return &resp, nil
@@ -136,7 +150,10 @@ func (c *Client) UpdateIssue(key, summary, description, dueDate, parentKey strin
 		fields.Summary = summary
 	}
 	if description != "" {
-		desc := adf.TextToADF(description)
+		desc, err := c.textToADF(description)
+		if err != nil {
+			return err
+		}
 		fields.Description = &desc
 	}
 	if dueDate != "" {
@@ -149,6 +166,29 @@ func (c *Client) UpdateIssue(key, summary, description, dueDate, parentKey strin
 	return c.doRequest("PUT", "/rest/api/3/issue/"+key, req, nil)
 }
 
+// DeleteIssue deletes an issue. Jira rejects deleting an issue that still
+// has sub-tasks unless deleteSubtasks is set, in which case they are
+// deleted along with it.
+func (c *Client) DeleteIssue(key string, deleteSubtasks bool) error {
+	path := "/rest/api/3/issue/" + key
+	if deleteSubtasks {
+		path += "?deleteSubtasks=true"
+	}
+	return c.doRequest("DELETE", path, nil, nil)
+}
+
+// ArchiveIssues archives the given issues. Archiving is only available on
+// Jira Premium and Enterprise; per-issue failures are reported in the
+// response's Errors rather than as a request error.
+func (c *Client) ArchiveIssues(keys []string) (*ArchiveIssuesResponse, error) {
+	req := ArchiveIssuesRequest{IssueIDsOrKeys: keys}
+	var resp ArchiveIssuesResponse
+	if err := c.doRequest("PUT", "/rest/api/3/issue/archive", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 // TransitionIssue transitions an issue to the given target status name.
 func (c *Client) TransitionIssue(key, targetStatus string) error {
 	var transResp TransitionsResponse
@@ -178,12 +218,182 @@ func (c *Client) AssignIssue(key string, accountID *string) error {
 	return c.doRequest("PUT", "/rest/api/3/issue/"+key+"/assignee", req, nil)
 }
 
+// EditIssue applies the field changes in edit to an issue, sending only the
+// fields that are set.
+func (c *Client) EditIssue(key string, edit IssueEdit) error {
+	fields := map[string]any{}
+	if edit.Summary != nil {
+		fields["summary"] = *edit.Summary
+	}
+	if edit.Description != nil {
+		if *edit.Description == "" {
+			fields["description"] = nil
+		} else {
+			desc, err := c.textToADF(*edit.Description)
+			if err != nil {
+				return err
+			}
+			fields["description"] = desc
+		}
+	}
+	if edit.DueDate != nil {
+		fields["duedate"] = nilIfEmpty(*edit.DueDate)
+	}
+	if edit.ParentKey != nil {
+		if *edit.ParentKey == "" {
+			fields["parent"] = nil
+		} else {
+			fields["parent"] = ParentRef{Key: *edit.ParentKey}
+		}
+	}
+	if edit.Labels != nil {
+		labels := *edit.Labels
+		if labels == nil {
+			labels = []string{}
+		}
+		fields["labels"] = labels
+	}
+	if edit.AssigneeID != nil {
+		if *edit.AssigneeID == "" {
+			fields["assignee"] = nil
+		} else {
+			fields["assignee"] = AccountRef{AccountID: *edit.AssigneeID}
+		}
+	}
+	if len(edit.CustomFields) > 0 {
+		custom, err := c.resolveFieldKeys(edit.CustomFields)
+		if err != nil {
+			return err
+		}
+		for id, v := range custom {
+			fields[id] = v
+		}
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	req := map[string]any{"fields": fields}
+	return c.doRequest("PUT", "/rest/api/3/issue/"+key, req, nil)
+}
+
+func nilIfEmpty(s string) any {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+// withCustomFields returns req (a request with a top-level "fields" object)
+// with the given custom fields merged into "fields". Custom field ids vary
+// per site, so they can't be static struct tags on the request types.
+func (c *Client) withCustomFields(req any, custom map[string]any) (any, error) {
+	if len(custom) == 0 {
+		return req, nil
+	}
+	resolved, err := c.resolveFieldKeys(custom)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling request: %w", err)
+	}
+	var body map[string]map[string]any
+	if err := json.Unmarshal(data, &body); err != nil {
+		return nil, fmt.Errorf("marshaling request: %w", err)
+	}
+	for id, v := range resolved {
+		body["fields"][id] = v
+	}
+	return body, nil
+}
+
+// resolveFieldKeys re-keys values by field id. Keys that already are field
+// ids (e.g. "customfield_10020", "labels") pass through; other keys are
+// matched case-insensitively against field names.
+func (c *Client) resolveFieldKeys(values map[string]any) (map[string]any, error) {
+	fields, err := c.GetFields()
+	if err != nil {
+		return nil, fmt.Errorf("resolving fields: %w", err)
+	}
+	resolved := make(map[string]any, len(values))
+	for key, v := range values {
+		id, err := matchFieldID(fields, key)
+		if err != nil {
+			return nil, err
+		}
+		resolved[id] = v
+	}
+	return resolved, nil
+}
+
+func matchFieldID(fields []Field, key string) (string, error) {
+	for _, f := range fields {
+		if f.ID == key {
+			return f.ID, nil
+		}
+	}
+	var ids []string
+	for _, f := range fields {
+		if strings.EqualFold(f.Name, key) {
+			ids = append(ids, f.ID)
+		}
+	}
+	switch len(ids) {
+	case 1:
+		return ids[0], nil
+	case 0:
+		return "", fmt.Errorf("no field named %q found on this Jira site", key)
+	}
+	return "", fmt.Errorf("field name %q is ambiguous; use one of the field ids instead: %s", key, strings.Join(ids, ", "))
+}
+
+// SetReporter sets the reporter of an issue by accountId.
+func (c *Client) SetReporter(key, accountID string) error {
+	req := map[string]interface{}{
+		"fields": map[string]interface{}{"reporter": AccountRef{AccountID: accountID}},
+	}
+	return c.doRequest("PUT", "/rest/api/3/issue/"+key, req, nil)
+}
+
+// AddWatcher adds a user (by accountId) to an issue's watchers.
+func (c *Client) AddWatcher(key, accountID string) error {
+	// The endpoint takes the bare accountId as a JSON string body.
+	return c.doRequest("POST", "/rest/api/3/issue/"+key+"/watchers", accountID, nil)
+}
+
+// RemoveWatcher removes a user (by accountId) from an issue's watchers.
+func (c *Client) RemoveWatcher(key, accountID string) error {
+	return c.doRequest("DELETE", "/rest/api/3/issue/"+key+"/watchers?accountId="+urlEncode(accountID), nil, nil)
+}
+
 // AddComment adds a comment to an issue.
 func (c *Client) AddComment(key, body string) error {
-	req := AddCommentRequest{Body: adf.TextToADF(body)}
+	doc, err := c.textToADF(body)
+	if err != nil {
+		return err
+	}
+	req := AddCommentRequest{Body: doc}
 	return c.doRequest("POST", "/rest/api/3/issue/"+key+"/comment", req, nil)
 }
 
+// textToADF converts markdown-like text to ADF, first resolving bare
+// @[name] mentions (name, email or "me") to @[name:accountId] via
+// ResolveUser so that they become real mentions.
+func (c *Client) textToADF(text string) (adf.Node, error) {
+	resolved, err := adf.ResolveMentions(text, func(query string) (string, string, error) {
+		u, err := c.ResolveUser(query)
+		if err != nil {
+			return "", "", err
+		}
+		return u.DisplayName, u.AccountID, nil
+	})
+	if err != nil {
+		return adf.Node{}, fmt.Errorf("resolving mention: %w", err)
+	}
+	return adf.TextToADF(resolved), nil
+}
+
 // SearchIssues searches for issues using JQL.
 func (c *Client) SearchIssues(jql string, maxResults int) (*SearchResponse, error) {
 	spFieldID, err := c.resolveStoryPointsFieldID()
@@ -214,7 +424,7 @@ func (c *Client) GetIssue(key string) (*Issue, error) {
 	if err != nil {
 		return nil, err
 	}
-	fieldsParam := "summary,status,issuetype,assignee,description,comment,duedate,attachment,parent"
+	fieldsParam := "summary,status,issuetype,assignee,description,comment,duedate,attachment,parent,subtasks,labels"
 	if spFieldID != "" {
 		fieldsParam += "," + spFieldID
 	}
@@ -476,6 +686,151 @@ func (c *Client) SearchUsers(query string, maxResults int) ([]User, error) {
 	return resp, nil
 }
 
+// ParseJQL validates a JQL query with strict validation, which also checks
+// that referenced fields, values and functions exist on this site.
+func (c *Client) ParseJQL(query string) (*ParsedJQLQuery, error) {
+	req := JQLParseRequest{Queries: []string{query}}
+	var resp JQLParseResponse
+	if err := c.doRequest("POST", "/rest/api/3/jql/parse?validation=strict", req, &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.Queries) == 0 {
+		return nil, fmt.Errorf("empty response from JQL parse endpoint")
+	}
+	return &resp.Queries[0], nil
+}
+
+// GetJQLAutocompleteData returns the fields, functions and reserved words
+// available for JQL queries.
+func (c *Client) GetJQLAutocompleteData() (*JQLAutocompleteData, error) {
+	var resp JQLAutocompleteData
+	if err := c.doRequest("GET", "/rest/api/3/jql/autocompletedata", nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetJQLSuggestions returns value suggestions for a JQL field, optionally
+// narrowed to values starting with prefix.
+func (c *Client) GetJQLSuggestions(fieldName, prefix string) ([]JQLSuggestion, error) {
+	path := "/rest/api/3/jql/autocompletedata/suggestions?fieldName=" + urlEncode(fieldName)
+	if prefix != "" {
+		path += "&fieldValue=" + urlEncode(prefix)
+	}
+	var resp JQLSuggestionsResponse
+	if err := c.doRequest("GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Results, nil
+}
+
+// filterExpand requests the filter properties that are omitted from filter
+// responses by default.
+const filterExpand = "description,owner,jql,viewUrl,favourite"
+
+// SearchFilters searches saved filters visible to the user. name matches
+// filter names case-insensitively as a substring; pass "" to list all.
+func (c *Client) SearchFilters(name string, maxResults int) (*FilterSearchResponse, error) {
+	path := fmt.Sprintf("/rest/api/3/filter/search?maxResults=%d&orderBy=name&expand=%s",
+		maxResults, filterExpand)
+	if name != "" {
+		path += "&filterName=" + urlEncode(name)
+	}
+	var resp FilterSearchResponse
+	if err := c.doRequest("GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetFavouriteFilters returns the filters the user has marked as favourite.
+func (c *Client) GetFavouriteFilters() ([]Filter, error) {
+	var resp []Filter
+	if err := c.doRequest("GET", "/rest/api/3/filter/favourite?expand="+filterExpand, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetFilter retrieves a saved filter by id.
+func (c *Client) GetFilter(id string) (*Filter, error) {
+	var resp Filter
+	if err := c.doRequest("GET", "/rest/api/3/filter/"+id+"?expand="+filterExpand, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ResolveFilter looks up a saved filter by numeric id or by exact
+// (case-insensitive) name. A name matching several filters is an error
+// listing the candidates, since silently picking one would run the wrong
+// query.
+func (c *Client) ResolveFilter(nameOrID string) (*Filter, error) {
+	if isAllDigits(nameOrID) {
+		return c.GetFilter(nameOrID)
+	}
+	resp, err := c.SearchFilters(nameOrID, 100)
+	if err != nil {
+		return nil, fmt.Errorf("resolving filter %q: %w", nameOrID, err)
+	}
+	var matches []Filter
+	target := strings.ToLower(nameOrID)
+	for _, f := range resp.Values {
+		if strings.ToLower(f.Name) == target {
+			matches = append(matches, f)
+		}
+	}
+	switch len(matches) {
+	case 1:
+		return &matches[0], nil
+	case 0:
+		return nil, fmt.Errorf("no filter named %q found", nameOrID)
+	}
+	candidates := make([]string, 0, len(matches))
+	for _, f := range matches {
+		owner := ""
+		if f.Owner != nil {
+			owner = " by " + f.Owner.DisplayName
+		}
+		candidates = append(candidates, fmt.Sprintf("%s (id %s%s)", f.Name, f.ID, owner))
+	}
+	return nil, fmt.Errorf("filter name %q is ambiguous; use the filter id instead: %s",
+		nameOrID, strings.Join(candidates, ", "))
+}
+
+// CreateFilter creates a saved filter.
+func (c *Client) CreateFilter(req FilterRequest) (*Filter, error) {
+	var resp Filter
+	if err := c.doRequest("POST", "/rest/api/3/filter?expand="+filterExpand, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// UpdateFilter replaces a saved filter's name, JQL and description.
+func (c *Client) UpdateFilter(id string, req FilterRequest) (*Filter, error) {
+	var resp Filter
+	if err := c.doRequest("PUT", "/rest/api/3/filter/"+id+"?expand="+filterExpand, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DeleteFilter deletes a saved filter.
+func (c *Client) DeleteFilter(id string) error {
+	return c.doRequest("DELETE", "/rest/api/3/filter/"+id, nil, nil)
+}
+
+// SetFilterFavourite adds the filter to (or removes it from) the user's
+// favourites.
+func (c *Client) SetFilterFavourite(id string, favourite bool) error {
+	method := "PUT"
+	if !favourite {
+		method = "DELETE"
+	}
+	return c.doRequest(method, "/rest/api/3/filter/"+id+"/favourite", nil, nil)
+}
+
 func urlEncode(s string) string {
 	return url.QueryEscape(s)
 }
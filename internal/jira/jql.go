@@ -0,0 +1,203 @@
+package jira
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// SearchCriteria holds the shortcut search options that BuildJQL turns
+// into JQL clauses. Empty fields are ignored.
+type SearchCriteria struct {
+	Projects []string
+	// Assignee is "me" (the current user), "none" (unassigned), or an
+	// accountId.
+	Assignee string
+	Statuses []string
+	Types    []string
+	Labels   []string
+	// Sprint is "active"/"open", "future", "closed", a sprint id, or a
+	// sprint name.
+	Sprint string
+	// UpdatedSince is a relative duration such as "7d", "2w" or "4h", or
+	// an absolute date (YYYY-MM-DD).
+	UpdatedSince string
+	Text         string
+	// OrderBy is the raw ORDER BY clause body, e.g. "updated DESC". It
+	// replaces any ORDER BY present in the base query.
+	OrderBy string
+}
+
+var (
+	orderByRe      = regexp.MustCompile(`(?i)\s*\border\s+by\b`)
+	relDurationRe  = regexp.MustCompile(`^\d+[wdhm]$`)
+	absoluteDateRe = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+)
+
+// BuildJQL combines base (which may be empty) with the clauses derived from
+// sc. The base query is parenthesised and ANDed with the new clauses; its
+// ORDER BY, if any, is kept unless sc.OrderBy overrides it.
+func BuildJQL(base string, sc SearchCriteria) (string, error) {
+	where, order := splitOrderBy(base)
+
+	extra := fieldClauses(sc)
+	if sc.Sprint != "" {
+		extra = append(extra, sprintClause(sc.Sprint))
+	}
+	if sc.UpdatedSince != "" {
+		switch {
+		case relDurationRe.MatchString(sc.UpdatedSince):
+			extra = append(extra, "updated >= "+jqlQuote("-"+sc.UpdatedSince))
+		case absoluteDateRe.MatchString(sc.UpdatedSince):
+			extra = append(extra, "updated >= "+jqlQuote(sc.UpdatedSince))
+		default:
+			return "", fmt.Errorf("invalid --updated-since %q: use a duration like 7d, 2w, 4h, 30m or a date (YYYY-MM-DD)", sc.UpdatedSince)
+		}
+	}
+
+	var clauses []string
+	if where != "" {
+		if len(extra) > 0 {
+			where = "(" + where + ")"
+		}
+		clauses = append(clauses, where)
+	}
+	clauses = append(clauses, extra...)
+
+	jql := strings.Join(clauses, " AND ")
+	if sc.OrderBy != "" {
+		order = sc.OrderBy
+	}
+	if order != "" {
+		if jql != "" {
+			jql += " "
+		}
+		jql += "ORDER BY " + order
+	}
+	return jql, nil
+}
+
+// fieldClauses returns the clauses for sc's plain field criteria.
+func fieldClauses(sc SearchCriteria) []string {
+	var clauses []string
+	if c := inClause("project", sc.Projects); c != "" {
+		clauses = append(clauses, c)
+	}
+	switch strings.ToLower(sc.Assignee) {
+	case "":
+	case "me":
+		clauses = append(clauses, "assignee = currentUser()")
+	case "none":
+		clauses = append(clauses, "assignee is EMPTY")
+	default:
+		clauses = append(clauses, "assignee = "+jqlQuote(sc.Assignee))
+	}
+	if c := inClause("status", sc.Statuses); c != "" {
+		clauses = append(clauses, c)
+	}
+	if c := inClause("issuetype", sc.Types); c != "" {
+		clauses = append(clauses, c)
+	}
+	if c := inClause("labels", sc.Labels); c != "" {
+		clauses = append(clauses, c)
+	}
+	if sc.Text != "" {
+		clauses = append(clauses, "text ~ "+jqlQuote(sc.Text))
+	}
+	return clauses
+}
+
+func sprintClause(sprint string) string {
+	switch strings.ToLower(sprint) {
+	case "active", "open":
+		return "sprint in openSprints()"
+	case "future":
+		return "sprint in futureSprints()"
+	case "closed":
+		return "sprint in closedSprints()"
+	}
+	if isAllDigits(sprint) {
+		return "sprint = " + sprint
+	}
+	return "sprint = " + jqlQuote(sprint)
+}
+
+func inClause(field string, values []string) string {
+	switch len(values) {
+	case 0:
+		return ""
+	case 1:
+		return field + " = " + jqlQuote(values[0])
+	}
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = jqlQuote(v)
+	}
+	return field + " in (" + strings.Join(quoted, ", ") + ")"
+}
+
+// splitOrderBy separates a JQL query into its WHERE part and the body of
+// its trailing ORDER BY clause. "order by" inside a string literal is not
+// a clause.
+func splitOrderBy(jql string) (where, order string) {
+	jql = strings.TrimSpace(jql)
+	quoted := quotedPositions(jql)
+	locs := orderByRe.FindAllStringIndex(jql, -1)
+	for i := len(locs) - 1; i >= 0; i-- {
+		last := locs[i]
+		if quoted[last[1]-1] {
+			continue
+		}
+		return strings.TrimSpace(jql[:last[0]]), strings.TrimSpace(jql[last[1]:])
+	}
+	return jql, ""
+}
+
+// quotedPositions reports, for each byte of jql, whether it is inside a
+// single- or double-quoted string literal.
+func quotedPositions(jql string) []bool {
+	quoted := make([]bool, len(jql))
+	var quote byte
+	for i := 0; i < len(jql); i++ {
+		c := jql[i]
+		if quote == 0 {
+			if c == '"' || c == '\'' {
+				quote = c
+			}
+			continue
+		}
+		quoted[i] = true
+		switch c {
+		case '\\':
+			if i+1 < len(jql) {
+				i++
+				quoted[i] = true
+			}
+		case quote:
+			quote = 0
+		}
+	}
+	return quoted
+}
+
+// jqlQuote returns s as a double-quoted JQL string literal.
+func jqlQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	return `"` + s + `"`
+}
+
+var jqlErrorPosRe = regexp.MustCompile(`\(line (\d+), character (\d+)\)`)
+
+// JQLErrorPosition extracts the "(line N, character M)" position Jira
+// appends to JQL parse errors. ok is false if the message has none.
+func JQLErrorPosition(msg string) (line, character int, ok bool) {
+	m := jqlErrorPosRe.FindStringSubmatch(msg)
+	if m == nil {
+		return 0, 0, false
+	}
+	line, _ = strconv.Atoi(m[1])
+	character, _ = strconv.Atoi(m[2])
+	return line, character, true
+}
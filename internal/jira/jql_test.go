@@ -0,0 +1,90 @@
+package jira
+
+import "testing"
+
+func TestBuildJQL_Criteria(t *testing.T) {
+	got, err := BuildJQL("", SearchCriteria{
+		Projects:     []string{"PROJ"},
+		Assignee:     "me",
+		Statuses:     []string{"To Do", "In Progress"},
+		Sprint:       "active",
+		UpdatedSince: "7d",
+		OrderBy:      "updated DESC",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `project = "PROJ" AND assignee = currentUser() AND status in ("To Do", "In Progress") AND sprint in openSprints() AND updated >= "-7d" ORDER BY updated DESC`
+	if got != want {
+		t.Errorf("got  %s\nwant %s", got, want)
+	}
+}
+
+func TestBuildJQL_BaseQueryKeepsOrderBy(t *testing.T) {
+	got, err := BuildJQL("project = PROJ OR labels = x order by created", SearchCriteria{Labels: []string{"bug"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `(project = PROJ OR labels = x) AND labels = "bug" ORDER BY created`
+	if got != want {
+		t.Errorf("got  %s\nwant %s", got, want)
+	}
+}
+
+func TestBuildJQL_OrderByOverridesBase(t *testing.T) {
+	got, err := BuildJQL("project = PROJ ORDER BY created", SearchCriteria{OrderBy: "rank"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `project = PROJ ORDER BY rank`
+	if got != want {
+		t.Errorf("got  %s\nwant %s", got, want)
+	}
+}
+
+func TestBuildJQL_OrderByInsideStringLiteral(t *testing.T) {
+	got, err := BuildJQL(`summary ~ "sort order by date" ORDER BY created`, SearchCriteria{Labels: []string{"bug"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `(summary ~ "sort order by date") AND labels = "bug" ORDER BY created`
+	if got != want {
+		t.Errorf("got  %s\nwant %s", got, want)
+	}
+
+	got, err = BuildJQL(`summary ~ 'order by'`, SearchCriteria{OrderBy: "rank"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = `summary ~ 'order by' ORDER BY rank`
+	if got != want {
+		t.Errorf("got  %s\nwant %s", got, want)
+	}
+}
+
+func TestBuildJQL_QuotesValues(t *testing.T) {
+	got, err := BuildJQL("", SearchCriteria{Text: `say "hi" \ bye`})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `text ~ "say \"hi\" \\ bye"`
+	if got != want {
+		t.Errorf("got  %s\nwant %s", got, want)
+	}
+}
+
+func TestBuildJQL_InvalidUpdatedSince(t *testing.T) {
+	if _, err := BuildJQL("", SearchCriteria{UpdatedSince: "7 days"}); err == nil {
+		t.Error("expected error for malformed --updated-since")
+	}
+}
+
+func TestJQLErrorPosition(t *testing.T) {
+	line, char, ok := JQLErrorPosition("Error in the JQL Query: Expecting operator but got 'foo'. (line 1, character 15)")
+	if !ok || line != 1 || char != 15 {
+		t.Errorf("got (%d, %d, %v), want (1, 15, true)", line, char, ok)
+	}
+	if _, _, ok := JQLErrorPosition("Field 'foo' does not exist"); ok {
+		t.Error("expected no position")
+	}
+}
@@ -8,12 +8,28 @@ type CreateIssueRequest struct {
 }
 
 type CreateIssueFields struct {
-	Project     ProjectKey `json:"project"`
-	Summary     string     `json:"summary"`
-	IssueType   IssueType  `json:"issuetype"`
-	Description *adf.Node  `json:"description,omitempty"`
-	DueDate     string     `json:"duedate,omitempty"`
-	Parent      *ParentRef `json:"parent,omitempty"`
+	Project     ProjectKey  `json:"project"`
+	Summary     string      `json:"summary"`
+	IssueType   IssueType   `json:"issuetype"`
+	Description *adf.Node   `json:"description,omitempty"`
+	DueDate     string      `json:"duedate,omitempty"`
+	Parent      *ParentRef  `json:"parent,omitempty"`
+	Labels      []string    `json:"labels,omitempty"`
+	Assignee    *AccountRef `json:"assignee,omitempty"`
+}
+
+// CreateIssueOptions holds the optional fields for CreateIssue.
+type CreateIssueOptions struct {
+	Description string
+	DueDate     string
+	// ParentKey sets the parent issue: an epic for standard issues, or the
+	// parent task for sub-tasks.
+	ParentKey  string
+	Labels     []string
+	AssigneeID string
+	// CustomFields are keyed by field id (e.g. "customfield_10020") or
+	// field name; values are sent as-is.
+	CustomFields map[string]any
 }
 
 type ParentRef struct {
@@ -48,6 +64,26 @@ type UpdateIssueFields struct {
 	Parent      *ParentRef `json:"parent,omitempty"`
 }
 
+// AccountRef references a user by accountId in issue fields such as
+// reporter.
+type AccountRef struct {
+	AccountID string `json:"accountId"`
+}
+
+// IssueEdit describes field changes for EditIssue. Nil members are left
+// unchanged; a pointer to an empty value clears the field.
+type IssueEdit struct {
+	Summary     *string
+	Description *string
+	DueDate     *string
+	ParentKey   *string
+	Labels      *[]string
+	AssigneeID  *string
+	// CustomFields are keyed by field id or field name; values are sent
+	// as-is.
+	CustomFields map[string]any
+}
+
 // AssignIssueRequest is the request body for assigning an issue.
 type AssignIssueRequest struct {
 	AccountID *string `json:"accountId"`
@@ -58,6 +94,25 @@ type AddCommentRequest struct {
 	Body adf.Node `json:"body"`
 }
 
+// ArchiveIssuesRequest is the request body for archiving issues.
+type ArchiveIssuesRequest struct {
+	IssueIDsOrKeys []string `json:"issueIdsOrKeys"`
+}
+
+// ArchiveIssuesResponse is the response from the issue archive endpoint.
+// Issues that could not be archived are grouped by error key (e.g.
+// "issueIsSubtask") rather than failing the whole request.
+type ArchiveIssuesResponse struct {
+	NumberOfIssuesUpdated int                     `json:"numberOfIssuesUpdated"`
+	Errors                map[string]ArchiveError `json:"errors"`
+}
+
+type ArchiveError struct {
+	Count          int      `json:"count"`
+	IssueIDsOrKeys []string `json:"issueIdsOrKeys"`
+	Message        string   `json:"message"`
+}
+
 // TransitionsResponse is the response from the transitions endpoint.
 type TransitionsResponse struct {
 	Transitions []Transition `json:"transitions"`
@@ -104,6 +159,8 @@ type IssueFields struct {
 	DueDate     string         `json:"duedate,omitempty"`
 	Attachment  []Attachment   `json:"attachment,omitempty"`
 	Parent      *ParentRef     `json:"parent,omitempty"`
+	Subtasks    []Issue        `json:"subtasks,omitempty"`
+	Labels      []string       `json:"labels,omitempty"`
 	// StoryPoints is populated separately since its Jira field id (e.g.
 	// customfield_10016) varies per site; it is not a static JSON key.
 	StoryPoints *float64 `json:"-"`
@@ -234,3 +291,90 @@ type FieldSchema struct {
 	Type   string `json:"type"`
 	Custom string `json:"custom,omitempty"`
 }
+
+// Filter represents a saved Jira filter.
+type Filter struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	JQL         string `json:"jql"`
+	Owner       *User  `json:"owner,omitempty"`
+	Favourite   bool   `json:"favourite"`
+	ViewURL     string `json:"viewUrl,omitempty"`
+}
+
+// FilterSearchResponse is the paginated response from the filter search endpoint.
+type FilterSearchResponse struct {
+	Values     []Filter `json:"values"`
+	StartAt    int      `json:"startAt"`
+	MaxResults int      `json:"maxResults"`
+	Total      int      `json:"total"`
+	IsLast     bool     `json:"isLast"`
+}
+
+// FilterRequest is the request body for creating or updating a filter.
+// Jira requires name on update as well, so callers updating a filter
+// should start from its current values. Description is omitted when nil;
+// a pointer to "" clears it.
+type FilterRequest struct {
+	Name        string  `json:"name"`
+	JQL         string  `json:"jql,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Favourite   *bool   `json:"favourite,omitempty"`
+}
+
+// JQLParseRequest is the request body for the JQL parse endpoint.
+type JQLParseRequest struct {
+	Queries []string `json:"queries"`
+}
+
+// JQLParseResponse is the response from the JQL parse endpoint.
+type JQLParseResponse struct {
+	Queries []ParsedJQLQuery `json:"queries"`
+}
+
+// ParsedJQLQuery is the parse result for a single query. Errors is empty
+// when the query is valid.
+type ParsedJQLQuery struct {
+	Query    string   `json:"query"`
+	Errors   []string `json:"errors,omitempty"`
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// JQLAutocompleteData is the response from the JQL autocomplete data
+// endpoint: the fields and functions usable in queries.
+type JQLAutocompleteData struct {
+	VisibleFieldNames    []JQLFieldReference    `json:"visibleFieldNames"`
+	VisibleFunctionNames []JQLFunctionReference `json:"visibleFunctionNames"`
+	JQLReservedWords     []string               `json:"jqlReservedWords"`
+}
+
+// JQLFieldReference describes a field usable in JQL. Orderable and
+// Searchable are "true"/"false" strings in the API response.
+type JQLFieldReference struct {
+	Value       string   `json:"value"`
+	DisplayName string   `json:"displayName"`
+	Orderable   string   `json:"orderable,omitempty"`
+	Searchable  string   `json:"searchable,omitempty"`
+	Operators   []string `json:"operators,omitempty"`
+	Types       []string `json:"types,omitempty"`
+}
+
+// JQLFunctionReference describes a function usable in JQL.
+type JQLFunctionReference struct {
+	Value       string   `json:"value"`
+	DisplayName string   `json:"displayName"`
+	IsList      string   `json:"isList,omitempty"`
+	Types       []string `json:"types,omitempty"`
+}
+
+// JQLSuggestionsResponse is the response from the JQL field value
+// suggestions endpoint.
+type JQLSuggestionsResponse struct {
+	Results []JQLSuggestion `json:"results"`
+}
+
+type JQLSuggestion struct {
+	Value       string `json:"value"`
+	DisplayName string `json:"displayName"`
+}
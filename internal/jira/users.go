@@ -0,0 +1,173 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// userNameCacheTTL is how long a user resolved by display name is cached,
+// after which the name is searched again so that a newly added user
+// sharing it is reported as an ambiguity.
+const userNameCacheTTL = 24 * time.Hour
+
+// cachedUser is a user cache entry, recording when it was resolved.
+type cachedUser struct {
+	User
+	CachedAt time.Time `json:"cached_at,omitempty"`
+}
+
+// legacyAccountIDRe matches the 24-character hex account ids issued before
+// Atlassian switched to the "<number>:<uuid>" format.
+var legacyAccountIDRe = regexp.MustCompile(`^[0-9a-f]{24}$`)
+
+// looksLikeAccountID reports whether s is shaped like an Atlassian account
+// id rather than an email address or display name.
+func looksLikeAccountID(s string) bool {
+	if strings.Contains(s, "@") || strings.Contains(s, " ") {
+		return false
+	}
+	return strings.Contains(s, ":") || legacyAccountIDRe.MatchString(s)
+}
+
+// SetUserCacheFile enables a persistent cache of resolved users at path.
+// The cache is per site (callers pass a site-specific path). Account ids
+// and email addresses each name a single user, so their entries are kept
+// indefinitely; display names can come to match a newly added user, so
+// theirs expire after userNameCacheTTL. A missing or unreadable file just
+// starts an empty cache.
+func (c *Client) SetUserCacheFile(path string) {
+	c.userCachePath = path
+	c.userCache = make(map[string]cachedUser)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return
+	}
+	var saved map[string]cachedUser
+	if json.Unmarshal(data, &saved) == nil && saved != nil {
+		c.userCache = saved
+	}
+}
+
+func (c *Client) cachedUser(query string) (*User, bool) {
+	e, ok := c.userCache[strings.ToLower(query)]
+	if !ok {
+		return nil, false
+	}
+	if isUserNameQuery(query) && time.Since(e.CachedAt) > userNameCacheTTL {
+		return nil, false
+	}
+	return &e.User, true
+}
+
+func (c *Client) cacheUser(query string, u User) {
+	if c.userCache == nil {
+		return
+	}
+	c.userCache[strings.ToLower(query)] = cachedUser{User: u, CachedAt: time.Now()}
+	if c.userCachePath == "" {
+		return
+	}
+	if data, err := json.Marshal(c.userCache); err == nil {
+		_ = os.WriteFile(c.userCachePath, data, 0600)
+	}
+}
+
+// isUserNameQuery reports whether query resolves a user by display name
+// rather than by account id or email address.
+func isUserNameQuery(query string) bool {
+	return !strings.Contains(query, "@") && !looksLikeAccountID(query)
+}
+
+// GetUser retrieves a user by account id.
+func (c *Client) GetUser(accountID string) (*User, error) {
+	var resp User
+	if err := c.doRequest("GET", "/rest/api/3/user?accountId="+urlEncode(accountID), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ResolveUser turns a user reference into a user. query may be "me" (the
+// authenticated user), an account id, an email address, or an exact
+// (case-insensitive) display name. A name or email matching more than one
+// user is an error listing the candidates rather than a guess.
+func (c *Client) ResolveUser(query string) (*User, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, fmt.Errorf("empty user reference")
+	}
+	if strings.EqualFold(query, "me") {
+		return c.GetMyself()
+	}
+	if u, ok := c.cachedUser(query); ok {
+		return u, nil
+	}
+
+	if looksLikeAccountID(query) {
+		u, err := c.GetUser(query)
+		if err != nil {
+			return nil, fmt.Errorf("resolving user %q: %w", query, err)
+		}
+		c.cacheUser(query, *u)
+		return u, nil
+	}
+
+	users, err := c.SearchUsers(query, 50)
+	if err != nil {
+		return nil, fmt.Errorf("resolving user %q: %w", query, err)
+	}
+
+	var matches []User
+	isEmail := strings.Contains(query, "@")
+	for _, u := range users {
+		if isEmail && strings.EqualFold(u.EmailAddress, query) ||
+			!isEmail && strings.EqualFold(u.DisplayName, query) {
+			matches = append(matches, u)
+		}
+	}
+	// Email addresses are often hidden by profile visibility settings, so
+	// a search for an email that returns exactly one user is taken as that
+	// user even though the address itself can't be compared.
+	if len(matches) == 0 && isEmail && len(users) == 1 {
+		matches = users
+	}
+
+	switch len(matches) {
+	case 1:
+		c.cacheUser(query, matches[0])
+		return &matches[0], nil
+	case 0:
+		if len(users) == 0 {
+			return nil, fmt.Errorf("no user found matching %q", query)
+		}
+		return nil, fmt.Errorf("no user exactly matching %q; candidates: %s", query, describeUsers(users))
+	}
+	return nil, fmt.Errorf("%q matches %d users; use an email address or account ID instead: %s",
+		query, len(matches), describeUsers(matches))
+}
+
+// ResolveAccountID is ResolveUser for callers that only need the account id.
+func (c *Client) ResolveAccountID(query string) (string, error) {
+	u, err := c.ResolveUser(query)
+	if err != nil {
+		return "", err
+	}
+	return u.AccountID, nil
+}
+
+func describeUsers(users []User) string {
+	parts := make([]string, 0, len(users))
+	for _, u := range users {
+		desc := u.DisplayName
+		if u.EmailAddress != "" {
+			desc += " <" + u.EmailAddress + ">"
+		}
+		desc += " (" + u.AccountID + ")"
+		parts = append(parts, desc)
+	}
+	return strings.Join(parts, ", ")
+}